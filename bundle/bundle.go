@@ -140,6 +140,16 @@ type Bundle struct {
 	// (direct only) planned action for each resource
 	PlannedActions map[deployplan.ResourceNode]deployplan.ActionType
 
+	// (direct only) changed fields for each resource with a planned action
+	PlannedChanges map[deployplan.ResourceNode][]deployplan.FieldChange
+
+	// (direct only) plan passed to "bundle deploy --plan"; if set, it is applied instead of calculating a new plan
+	SavedPlan *deployplan.Plan
+
+	// (direct only) resource selectors passed to "bundle deploy --only", e.g. "jobs.foo" or "jobs.etl_*";
+	// if set, only the matching resources and the resources they reference are deployed
+	OnlyResources []string
+
 	// if true, we skip approval checks for deploy, destroy resources and delete
 	// files
 	AutoApprove bool
@@ -2,6 +2,7 @@ package resources
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/url"
 
@@ -44,6 +45,42 @@ type DashboardConfig struct {
 	EmbedCredentials bool `json:"embed_credentials,omitempty"`
 }
 
+// UnmarshalJSON is defined explicitly because otherwise the method promoted from
+// the embedded [dashboards.Dashboard] is used and the additional fields are dropped.
+func (c *DashboardConfig) UnmarshalJSON(b []byte) error {
+	var additional struct {
+		SerializedDashboard any  `json:"serialized_dashboard,omitempty"`
+		EmbedCredentials    bool `json:"embed_credentials,omitempty"`
+	}
+	if err := json.Unmarshal(b, &additional); err != nil {
+		return err
+	}
+
+	// The embedded struct declares serialized_dashboard as a string, so remove
+	// the additional fields before unmarshalling it.
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(b, &fields); err != nil {
+		return err
+	}
+	delete(fields, "serialized_dashboard")
+	delete(fields, "embed_credentials")
+	rest, err := json.Marshal(fields)
+	if err != nil {
+		return err
+	}
+	if err := marshal.Unmarshal(rest, &c.Dashboard); err != nil {
+		return err
+	}
+
+	c.SerializedDashboard = additional.SerializedDashboard
+	c.EmbedCredentials = additional.EmbedCredentials
+	return nil
+}
+
+func (c DashboardConfig) MarshalJSON() ([]byte, error) {
+	return marshal.Marshal(c)
+}
+
 type Dashboard struct {
 	ID             string                `json:"id,omitempty" bundle:"readonly"`
 	Permissions    []DashboardPermission `json:"permissions,omitempty"`
@@ -0,0 +1,24 @@
+package resources
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDashboardJSONRoundTrip(t *testing.T) {
+	input := `{"display_name":"x","embed_credentials":true,"parent_path":"/p","serialized_dashboard":{"pages":[]}}`
+
+	var d Dashboard
+	require.NoError(t, json.Unmarshal([]byte(input), &d))
+	assert.Equal(t, "x", d.DisplayName)
+	assert.Equal(t, "/p", d.ParentPath)
+	assert.True(t, d.EmbedCredentials)
+	assert.Equal(t, map[string]any{"pages": []any{}}, d.SerializedDashboard)
+
+	output, err := json.Marshal(d)
+	require.NoError(t, err)
+	assert.JSONEq(t, input, string(output))
+}
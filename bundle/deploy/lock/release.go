@@ -16,6 +16,8 @@ const (
 	GoalUnbind  = Goal("unbind")
 	GoalDeploy  = Goal("deploy")
 	GoalDestroy = Goal("destroy")
+	GoalMigrate = Goal("migrate")
+	GoalTest    = Goal("test")
 )
 
 type release struct {
@@ -48,7 +50,7 @@ func (m *release) Apply(ctx context.Context, b *bundle.Bundle) diag.Diagnostics
 	switch m.goal {
 	case GoalDeploy:
 		return diag.FromErr(b.Locker.Unlock(ctx))
-	case GoalBind, GoalUnbind:
+	case GoalBind, GoalUnbind, GoalMigrate, GoalTest:
 		return diag.FromErr(b.Locker.Unlock(ctx))
 	case GoalDestroy:
 		return diag.FromErr(b.Locker.Unlock(ctx, locker.AllowLockFileNotExist))
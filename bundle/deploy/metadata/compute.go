@@ -6,6 +6,7 @@ import (
 
 	"github.com/databricks/cli/bundle"
 	"github.com/databricks/cli/bundle/config"
+	"github.com/databricks/cli/bundle/config/resources"
 	"github.com/databricks/cli/bundle/metadata"
 	"github.com/databricks/cli/libs/diag"
 )
@@ -37,6 +38,12 @@ func (m *compute) Apply(_ context.Context, b *bundle.Bundle) diag.Diagnostics {
 	// Set job config paths in metadata
 	jobsMetadata := make(map[string]*metadata.Job)
 	for name, job := range b.Config.Resources.Jobs {
+		// Jobs that are only present in the deployment state are not defined in the configuration,
+		// e.g. a job that was removed from the configuration but not deleted by a partial deployment.
+		if job.ModifiedStatus == resources.ModifiedStatusDeleted {
+			continue
+		}
+
 		// Compute config file path the job is defined in, relative to the bundle
 		// root
 		l := b.Config.GetLocation("resources.jobs." + name)
@@ -16,9 +16,20 @@ type dashboardState struct {
 }
 
 func collectDashboardsFromState(ctx context.Context, b *bundle.Bundle) ([]dashboardState, error) {
-	state, err := ParseResourcesState(ctx, b)
-	if err != nil && state == nil {
-		return nil, err
+	var state ExportedResourcesMap
+
+	if b.DirectDeployment {
+		err := b.OpenResourceDatabase(ctx)
+		if err != nil {
+			return nil, err
+		}
+		state = b.ResourceDatabase.ExportState(ctx)
+	} else {
+		var err error
+		state, err = ParseResourcesState(ctx, b)
+		if err != nil && state == nil {
+			return nil, err
+		}
 	}
 
 	var dashboards []dashboardState
@@ -45,11 +56,6 @@ func (l *checkDashboardsModifiedRemotely) Apply(ctx context.Context, b *bundle.B
 		return nil
 	}
 
-	if b.DirectDeployment {
-		// TODO: not implemented yet
-		return nil
-	}
-
 	// If the user has forced the deployment, skip this check.
 	if b.Config.Bundle.Force {
 		return nil
@@ -19,10 +19,12 @@ type (
 )
 
 // Partial representation of the Terraform state file format.
-// We are only interested global version and serial numbers,
+// We are only interested in the global version, lineage and serial numbers,
 // plus resource types, names, modes, IDs, and ETags (for dashboards).
 type resourcesState struct {
 	Version   int             `json:"version"`
+	Lineage   string          `json:"lineage"`
+	Serial    int             `json:"serial"`
 	Resources []stateResource `json:"resources"`
 }
 
@@ -51,27 +53,11 @@ type stateInstanceAttributes struct {
 
 // Returns a mapping group -> name -> stateInstanceAttributes
 func ParseResourcesState(ctx context.Context, b *bundle.Bundle) (ExportedResourcesMap, error) {
-	cacheDir, err := Dir(ctx, b)
-	if err != nil {
-		return nil, err
-	}
-	rawState, err := os.ReadFile(filepath.Join(cacheDir, b.StateFilename()))
-	if err != nil {
-		if errors.Is(err, os.ErrNotExist) {
-			return nil, nil
-		}
-		return nil, err
-	}
-	var state resourcesState
-	err = json.Unmarshal(rawState, &state)
-	if err != nil {
+	state, err := readResourcesState(ctx, b)
+	if err != nil || state == nil {
 		return nil, err
 	}
 
-	if state.Version != SupportedStateVersion {
-		return nil, fmt.Errorf("unsupported deployment state version: %d. Try re-deploying the bundle", state.Version)
-	}
-
 	result := make(ExportedResourcesMap)
 
 	for _, resource := range state.Resources {
@@ -106,3 +92,38 @@ func ParseResourcesState(ctx context.Context, b *bundle.Bundle) (ExportedResourc
 
 	return result, nil
 }
+
+// ParseStateLineage returns the lineage and serial of the local Terraform state.
+// The lineage is empty if there is no state.
+func ParseStateLineage(ctx context.Context, b *bundle.Bundle) (string, int, error) {
+	state, err := readResourcesState(ctx, b)
+	if err != nil || state == nil {
+		return "", 0, err
+	}
+	return state.Lineage, state.Serial, nil
+}
+
+func readResourcesState(ctx context.Context, b *bundle.Bundle) (*resourcesState, error) {
+	cacheDir, err := Dir(ctx, b)
+	if err != nil {
+		return nil, err
+	}
+	rawState, err := os.ReadFile(filepath.Join(cacheDir, b.StateFilename()))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var state resourcesState
+	err = json.Unmarshal(rawState, &state)
+	if err != nil {
+		return nil, err
+	}
+
+	if state.Version != SupportedStateVersion {
+		return nil, fmt.Errorf("unsupported deployment state version: %d. Try re-deploying the bundle", state.Version)
+	}
+
+	return &state, nil
+}
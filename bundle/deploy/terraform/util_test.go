@@ -3,6 +3,7 @@ package terraform
 import (
 	"context"
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/databricks/cli/bundle"
@@ -96,3 +97,34 @@ func TestParseResourcesStateWithExistingStateFile(t *testing.T) {
 	}
 	assert.Equal(t, expected, state)
 }
+
+func TestParseStateLineage(t *testing.T) {
+	ctx := context.Background()
+	b := &bundle.Bundle{
+		BundleRootPath: t.TempDir(),
+		Config: config.Root{
+			Bundle: config.Bundle{
+				Target: "whatever",
+				Terraform: &config.Terraform{
+					ExecPath: "terraform",
+				},
+			},
+		},
+	}
+
+	lineage, serial, err := ParseStateLineage(ctx, b)
+	require.NoError(t, err)
+	assert.Equal(t, "", lineage)
+	assert.Equal(t, 0, serial)
+
+	cacheDir, err := Dir(ctx, b)
+	require.NoError(t, err)
+	data := []byte(`{"version": 4, "serial": 7, "lineage": "abc", "resources": []}`)
+	err = os.WriteFile(filepath.Join(cacheDir, b.StateFilename()), data, 0o600)
+	require.NoError(t, err)
+
+	lineage, serial, err = ParseStateLineage(ctx, b)
+	require.NoError(t, err)
+	assert.Equal(t, "abc", lineage)
+	assert.Equal(t, 7, serial)
+}
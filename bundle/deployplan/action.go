@@ -8,7 +8,38 @@ import (
 type Action struct {
 	ResourceNode
 
-	ActionType ActionType
+	ActionType ActionType `json:"action"`
+
+	// Changes lists the fields that differ between the deployed state and the configuration.
+	// Only populated by the direct deployment engine.
+	Changes []FieldChange `json:"changes,omitempty"`
+}
+
+// FieldChange describes a single field that differs between the deployed state and the configuration.
+type FieldChange struct {
+	// Path of the field, e.g. ".tasks[0].notebook_task.notebook_path"
+	Path string `json:"path"`
+
+	// Old is the value in the deployed state, New is the value in the configuration.
+	Old any `json:"old"`
+	New any `json:"new"`
+
+	// Recreate is set if changing this field requires the resource to be recreated.
+	Recreate bool `json:"recreate,omitempty"`
+}
+
+// Drift describes a deployed resource that was changed or deleted outside of the bundle.
+type Drift struct {
+	ResourceNode
+
+	// ID of the resource in the deployment state.
+	ID string `json:"id"`
+
+	// Deleted is set if the resource no longer exists.
+	Deleted bool `json:"deleted,omitempty"`
+
+	// Changes lists the fields that differ; Old is the deployed value and New is the remote value.
+	Changes []FieldChange `json:"changes,omitempty"`
 }
 
 func (a Action) String() string {
@@ -35,6 +66,7 @@ const (
 	ActionTypeUpdate       ActionType = "update"
 	ActionTypeUpdateWithID ActionType = "update_with_id"
 	ActionTypeRecreate     ActionType = "recreate"
+	ActionTypeResize       ActionType = "resize"
 )
 
 var ShortName = map[ActionType]ActionType{
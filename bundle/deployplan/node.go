@@ -2,10 +2,10 @@ package deployplan
 
 type ResourceNode struct {
 	// Resource group in the config, e.g. "jobs" or "pipelines"
-	Group string
+	Group string `json:"group"`
 
 	// Key of the resource in the config, e.g. "foo" if job is located at resources.jobs.foo
-	Key string
+	Key string `json:"key"`
 }
 
 // String implements StringerComparable
@@ -0,0 +1,61 @@
+package deployplan
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// PlanVersion is the version of the plan file format written by Save.
+const PlanVersion = 1
+
+// Plan is a deployment plan that is saved by "bundle plan --out" and applied by "bundle deploy --plan".
+type Plan struct {
+	Version int `json:"plan_version"`
+
+	// Lineage and Serial identify the deployment state the plan was calculated against.
+	// Lineage is empty if there was no state yet. Applying the plan is refused if the state has changed since.
+	Lineage string `json:"lineage"`
+	Serial  int    `json:"serial"`
+
+	Actions []Action `json:"actions"`
+
+	// Config holds the resolved configuration of every resource that is created or updated by the plan,
+	// keyed by group and resource key.
+	Config map[string]map[string]json.RawMessage `json:"config,omitempty"`
+}
+
+// Save writes the plan to the file at path.
+func (p *Plan) Save(path string) error {
+	data, err := json.MarshalIndent(p, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	err = os.WriteFile(path, append(data, '\n'), 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to save plan to %s: %w", path, err)
+	}
+
+	return nil
+}
+
+// LoadPlan reads a plan previously written by Save.
+func LoadPlan(path string) (*Plan, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read plan: %w", err)
+	}
+
+	var plan Plan
+	err = json.Unmarshal(data, &plan)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse plan %s: %w", path, err)
+	}
+
+	if plan.Version != PlanVersion {
+		return nil, fmt.Errorf("unsupported plan version %d in %s, expected %d; re-create the plan with this version of the CLI", plan.Version, path, PlanVersion)
+	}
+
+	return &plan, nil
+}
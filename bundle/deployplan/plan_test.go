@@ -0,0 +1,50 @@
+package deployplan
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlanSaveLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "plan.json")
+
+	plan := &Plan{
+		Version: PlanVersion,
+		Lineage: "lineage",
+		Serial:  3,
+		Actions: []Action{
+			{
+				ResourceNode: ResourceNode{Group: "jobs", Key: "foo"},
+				ActionType:   ActionTypeUpdate,
+				Changes: []FieldChange{
+					{Path: ".name", Old: "old", New: "new"},
+				},
+			},
+		},
+		Config: map[string]map[string]json.RawMessage{
+			"jobs": {"foo": json.RawMessage(`{"name":"new"}`)},
+		},
+	}
+
+	require.NoError(t, plan.Save(path))
+
+	loaded, err := LoadPlan(path)
+	require.NoError(t, err)
+	assert.Equal(t, plan.Lineage, loaded.Lineage)
+	assert.Equal(t, plan.Serial, loaded.Serial)
+	assert.Equal(t, plan.Actions, loaded.Actions)
+	assert.JSONEq(t, `{"name":"new"}`, string(loaded.Config["jobs"]["foo"]))
+}
+
+func TestLoadPlanUnsupportedVersion(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "plan.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{"plan_version": 2}`), 0o600))
+
+	_, err := LoadPlan(path)
+	assert.ErrorContains(t, err, "unsupported plan version 2")
+}
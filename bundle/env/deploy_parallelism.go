@@ -0,0 +1,14 @@
+package env
+
+import "context"
+
+// DeployParallelismVariable names the environment variable that holds the maximum number of
+// resources that the direct deployment engine deploys or destroys concurrently.
+const DeployParallelismVariable = "DATABRICKS_BUNDLE_DEPLOY_PARALLELISM"
+
+// DeployParallelism returns the maximum number of resources to deploy or destroy concurrently.
+func DeployParallelism(ctx context.Context) (string, bool) {
+	return get(ctx, []string{
+		DeployParallelismVariable,
+	})
+}
@@ -0,0 +1,28 @@
+package env
+
+import (
+	"context"
+	"testing"
+
+	"github.com/databricks/cli/internal/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeployParallelism(t *testing.T) {
+	ctx := context.Background()
+
+	testutil.CleanupEnvironment(t)
+
+	t.Run("set", func(t *testing.T) {
+		t.Setenv("DATABRICKS_BUNDLE_DEPLOY_PARALLELISM", "4")
+		parallelism, ok := DeployParallelism(ctx)
+		assert.True(t, ok)
+		assert.Equal(t, "4", parallelism)
+	})
+
+	t.Run("not set", func(t *testing.T) {
+		parallelism, ok := DeployParallelism(ctx)
+		assert.False(t, ok)
+		assert.Equal(t, "", parallelism)
+	})
+}
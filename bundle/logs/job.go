@@ -0,0 +1,168 @@
+package logs
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/databricks/cli/libs/log"
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/databricks-sdk-go/service/jobs"
+)
+
+// JobRun is the output of a job run, per task.
+type JobRun struct {
+	RunId        int64     `json:"run_id"`
+	RunPageUrl   string    `json:"run_page_url"`
+	StartTime    time.Time `json:"start_time"`
+	State        string    `json:"state"`
+	StateMessage string    `json:"state_message,omitempty"`
+	Tasks        []JobTask `json:"tasks"`
+}
+
+// JobTask is the output of a task of a job run.
+type JobTask struct {
+	TaskKey    string `json:"task_key"`
+	RunId      int64  `json:"run_id"`
+	State      string `json:"state"`
+	Output     string `json:"output,omitempty"`
+	Error      string `json:"error,omitempty"`
+	ErrorTrace string `json:"error_trace,omitempty"`
+}
+
+// runState returns the result state of a run, or its life cycle state if it has no result.
+func runState(state *jobs.RunState) string {
+	if state == nil {
+		return ""
+	}
+	if state.ResultState != "" {
+		return string(state.ResultState)
+	}
+	return string(state.LifeCycleState)
+}
+
+func isFailed(state *jobs.RunState) bool {
+	if state == nil {
+		return false
+	}
+	return state.LifeCycleState == jobs.RunLifeCycleStateInternalError ||
+		state.ResultState == jobs.RunResultStateFailed ||
+		state.ResultState == jobs.RunResultStateTimedout
+}
+
+// JobRuns returns the output of the selected runs of a job, most recent run first.
+func JobRuns(ctx context.Context, w *databricks.WorkspaceClient, jobId int64, opts Options) ([]JobRun, error) {
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+
+	runIds, err := selectJobRuns(ctx, w, jobId, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]JobRun, 0, len(runIds))
+	for _, runId := range runIds {
+		run, err := w.Jobs.GetRun(ctx, jobs.GetRunRequest{
+			RunId: runId,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if run.JobId != jobId {
+			return nil, fmt.Errorf("run %d does not belong to job %d", runId, jobId)
+		}
+		result = append(result, getJobRun(ctx, w, run))
+	}
+	return result, nil
+}
+
+func selectJobRuns(ctx context.Context, w *databricks.WorkspaceClient, jobId int64, opts Options) ([]int64, error) {
+	if opts.RunId != "" {
+		runId, err := strconv.ParseInt(opts.RunId, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid run ID %#v: expected an integer", opts.RunId)
+		}
+		return []int64{runId}, nil
+	}
+
+	// Runs are listed in descending order by start time.
+	var runIds []int64
+	it := w.Jobs.ListRuns(ctx, jobs.ListRunsRequest{
+		JobId:         jobId,
+		CompletedOnly: true,
+	})
+	for len(runIds) < opts.Last && it.HasNext(ctx) {
+		run, err := it.Next(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if opts.Failed && !isFailed(run.State) {
+			continue
+		}
+		runIds = append(runIds, run.RunId)
+	}
+	if len(runIds) == 0 {
+		if opts.Failed {
+			return nil, fmt.Errorf("job %d has no failed runs", jobId)
+		}
+		return nil, fmt.Errorf("job %d has no completed runs", jobId)
+	}
+	return runIds, nil
+}
+
+func getJobRun(ctx context.Context, w *databricks.WorkspaceClient, run *jobs.Run) JobRun {
+	result := JobRun{
+		RunId:      run.RunId,
+		RunPageUrl: run.RunPageUrl,
+		StartTime:  time.UnixMilli(run.StartTime).UTC(),
+		State:      runState(run.State),
+		Tasks:      make([]JobTask, 0, len(run.Tasks)),
+	}
+	if run.State != nil {
+		result.StateMessage = run.State.StateMessage
+	}
+
+	for _, task := range run.Tasks {
+		result.Tasks = append(result.Tasks, getJobTask(ctx, w, task))
+	}
+	return result
+}
+
+func getJobTask(ctx context.Context, w *databricks.WorkspaceClient, task jobs.RunTask) JobTask {
+	result := JobTask{
+		TaskKey: task.TaskKey,
+		RunId:   task.RunId,
+		State:   runState(task.State),
+	}
+
+	// Tasks that didn't run, e.g. because an upstream task failed, have no output.
+	if task.RunId == 0 {
+		return result
+	}
+
+	out, err := w.Jobs.GetRunOutput(ctx, jobs.GetRunOutputRequest{
+		RunId: task.RunId,
+	})
+	if err != nil {
+		log.Debugf(ctx, "Unable to fetch the output of task %s: %s", task.TaskKey, err)
+		return result
+	}
+
+	result.Error = out.Error
+	result.ErrorTrace = out.ErrorTrace
+	switch {
+	case out.Logs != "":
+		result.Output = out.Logs
+		if out.LogsTruncated {
+			result.Output += "\n[truncated...]"
+		}
+	case out.NotebookOutput != nil && out.NotebookOutput.Result != "":
+		result.Output = out.NotebookOutput.Result
+		if out.NotebookOutput.Truncated {
+			result.Output += "\n[truncated...]"
+		}
+	}
+	return result
+}
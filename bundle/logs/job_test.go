@@ -0,0 +1,167 @@
+package logs
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/databricks/databricks-sdk-go/experimental/mocks"
+	"github.com/databricks/databricks-sdk-go/listing"
+	"github.com/databricks/databricks-sdk-go/service/jobs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func terminatedState(resultState jobs.RunResultState) *jobs.RunState {
+	return &jobs.RunState{
+		LifeCycleState: jobs.RunLifeCycleStateTerminated,
+		ResultState:    resultState,
+	}
+}
+
+func TestJobRunsLast(t *testing.T) {
+	ctx := context.Background()
+	m := mocks.NewMockWorkspaceClient(t)
+	jobApi := m.GetMockJobsAPI()
+
+	runs := listing.SliceIterator[jobs.BaseRun]([]jobs.BaseRun{
+		{RunId: 3, State: terminatedState(jobs.RunResultStateSuccess)},
+		{RunId: 2, State: terminatedState(jobs.RunResultStateFailed)},
+		{RunId: 1, State: terminatedState(jobs.RunResultStateFailed)},
+	})
+	jobApi.EXPECT().ListRuns(mock.Anything, jobs.ListRunsRequest{
+		JobId:         123,
+		CompletedOnly: true,
+	}).Return(&runs)
+
+	for _, runId := range []int64{3, 2} {
+		jobApi.EXPECT().GetRun(mock.Anything, jobs.GetRunRequest{RunId: runId}).Return(&jobs.Run{
+			JobId: 123,
+			RunId: runId,
+		}, nil)
+	}
+
+	result, err := JobRuns(ctx, m.WorkspaceClient, 123, Options{Last: 2})
+	require.NoError(t, err)
+	require.Len(t, result, 2)
+	assert.Equal(t, int64(3), result[0].RunId)
+	assert.Equal(t, int64(2), result[1].RunId)
+}
+
+func TestJobRunsFailed(t *testing.T) {
+	ctx := context.Background()
+	m := mocks.NewMockWorkspaceClient(t)
+	jobApi := m.GetMockJobsAPI()
+
+	runs := listing.SliceIterator[jobs.BaseRun]([]jobs.BaseRun{
+		{RunId: 3, State: terminatedState(jobs.RunResultStateSuccess)},
+		{RunId: 2, State: terminatedState(jobs.RunResultStateFailed)},
+	})
+	jobApi.EXPECT().ListRuns(mock.Anything, jobs.ListRunsRequest{
+		JobId:         123,
+		CompletedOnly: true,
+	}).Return(&runs)
+
+	jobApi.EXPECT().GetRun(mock.Anything, jobs.GetRunRequest{RunId: 2}).Return(&jobs.Run{
+		JobId:      123,
+		RunId:      2,
+		RunPageUrl: "https://host/run/2",
+		StartTime:  time.Date(2025, 1, 15, 10, 30, 0, 0, time.UTC).UnixMilli(),
+		State: &jobs.RunState{
+			LifeCycleState: jobs.RunLifeCycleStateTerminated,
+			ResultState:    jobs.RunResultStateFailed,
+			StateMessage:   "Task b failed",
+		},
+		Tasks: []jobs.RunTask{
+			{TaskKey: "a", RunId: 21, State: terminatedState(jobs.RunResultStateSuccess)},
+			{TaskKey: "b", RunId: 22, State: terminatedState(jobs.RunResultStateFailed)},
+			{TaskKey: "c", State: terminatedState(jobs.RunResultStateUpstreamFailed)},
+		},
+	}, nil)
+
+	jobApi.EXPECT().GetRunOutput(mock.Anything, jobs.GetRunOutputRequest{RunId: 21}).Return(&jobs.RunOutput{
+		NotebookOutput: &jobs.NotebookOutput{Result: "done"},
+	}, nil)
+	jobApi.EXPECT().GetRunOutput(mock.Anything, jobs.GetRunOutputRequest{RunId: 22}).Return(&jobs.RunOutput{
+		Logs:          "some output",
+		LogsTruncated: true,
+		Error:         "ValueError: boom",
+		ErrorTrace:    "Traceback...",
+	}, nil)
+
+	result, err := JobRuns(ctx, m.WorkspaceClient, 123, Options{Last: 1, Failed: true})
+	require.NoError(t, err)
+	assert.Equal(t, []JobRun{
+		{
+			RunId:        2,
+			RunPageUrl:   "https://host/run/2",
+			StartTime:    time.Date(2025, 1, 15, 10, 30, 0, 0, time.UTC),
+			State:        "FAILED",
+			StateMessage: "Task b failed",
+			Tasks: []JobTask{
+				{TaskKey: "a", RunId: 21, State: "SUCCESS", Output: "done"},
+				{TaskKey: "b", RunId: 22, State: "FAILED", Output: "some output\n[truncated...]", Error: "ValueError: boom", ErrorTrace: "Traceback..."},
+				{TaskKey: "c", State: "UPSTREAM_FAILED"},
+			},
+		},
+	}, result)
+}
+
+func TestJobRunsNoFailedRuns(t *testing.T) {
+	ctx := context.Background()
+	m := mocks.NewMockWorkspaceClient(t)
+
+	runs := listing.SliceIterator[jobs.BaseRun]([]jobs.BaseRun{
+		{RunId: 1, State: terminatedState(jobs.RunResultStateSuccess)},
+	})
+	m.GetMockJobsAPI().EXPECT().ListRuns(mock.Anything, mock.Anything).Return(&runs)
+
+	_, err := JobRuns(ctx, m.WorkspaceClient, 123, Options{Last: 1, Failed: true})
+	assert.EqualError(t, err, "job 123 has no failed runs")
+}
+
+func TestJobRunsRunId(t *testing.T) {
+	ctx := context.Background()
+	m := mocks.NewMockWorkspaceClient(t)
+	jobApi := m.GetMockJobsAPI()
+
+	jobApi.EXPECT().GetRun(mock.Anything, jobs.GetRunRequest{RunId: 456}).Return(&jobs.Run{
+		JobId: 123,
+		RunId: 456,
+		Tasks: []jobs.RunTask{{TaskKey: "a", RunId: 457}},
+	}, nil)
+
+	// Failing to fetch the output of a task doesn't fail the command.
+	jobApi.EXPECT().GetRunOutput(mock.Anything, jobs.GetRunOutputRequest{RunId: 457}).Return(nil, errors.New("not found"))
+
+	result, err := JobRuns(ctx, m.WorkspaceClient, 123, Options{RunId: "456"})
+	require.NoError(t, err)
+	require.Len(t, result, 1)
+	assert.Equal(t, []JobTask{{TaskKey: "a", RunId: 457}}, result[0].Tasks)
+}
+
+func TestJobRunsRunIdOfOtherJob(t *testing.T) {
+	ctx := context.Background()
+	m := mocks.NewMockWorkspaceClient(t)
+
+	m.GetMockJobsAPI().EXPECT().GetRun(mock.Anything, jobs.GetRunRequest{RunId: 456}).Return(&jobs.Run{
+		JobId: 789,
+		RunId: 456,
+	}, nil)
+
+	_, err := JobRuns(ctx, m.WorkspaceClient, 123, Options{RunId: "456"})
+	assert.EqualError(t, err, "run 456 does not belong to job 123")
+}
+
+func TestJobRunsInvalidOptions(t *testing.T) {
+	ctx := context.Background()
+	m := mocks.NewMockWorkspaceClient(t)
+
+	_, err := JobRuns(ctx, m.WorkspaceClient, 123, Options{RunId: "abc"})
+	assert.EqualError(t, err, `invalid run ID "abc": expected an integer`)
+
+	_, err = JobRuns(ctx, m.WorkspaceClient, 123, Options{Last: 0})
+	assert.EqualError(t, err, "the number of runs must be at least 1")
+}
@@ -0,0 +1,24 @@
+// Package logs retrieves the output of past runs of the jobs and pipelines in a bundle.
+package logs
+
+import "errors"
+
+// Options selects the runs to retrieve the output of.
+type Options struct {
+	// RunId is the ID of the job run or pipeline update to retrieve.
+	// If set, Last and Failed are ignored.
+	RunId string
+
+	// Last is the number of most recent runs to retrieve.
+	Last int
+
+	// Failed only selects runs that failed.
+	Failed bool
+}
+
+func (o Options) validate() error {
+	if o.RunId == "" && o.Last < 1 {
+		return errors.New("the number of runs must be at least 1")
+	}
+	return nil
+}
@@ -0,0 +1,119 @@
+package logs
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/databricks-sdk-go/service/pipelines"
+)
+
+// PipelineUpdate is the event log of a pipeline update.
+type PipelineUpdate struct {
+	UpdateId     string                    `json:"update_id"`
+	CreationTime time.Time                 `json:"creation_time"`
+	State        string                    `json:"state"`
+	Events       []pipelines.PipelineEvent `json:"events"`
+}
+
+// PipelineUpdates returns the event logs of the selected updates of a pipeline, most recent update first.
+// The events of every update are in chronological order.
+func PipelineUpdates(ctx context.Context, w *databricks.WorkspaceClient, pipelineId string, opts Options) ([]PipelineUpdate, error) {
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+
+	updates, err := selectPipelineUpdates(ctx, w, pipelineId, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]PipelineUpdate, 0, len(updates))
+	for _, update := range updates {
+		events, err := w.Pipelines.ListPipelineEventsAll(ctx, pipelines.ListPipelineEventsRequest{
+			PipelineId: pipelineId,
+			Filter:     fmt.Sprintf("update_id = '%s'", update.UpdateId),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch events for update %s: %w", update.UpdateId, err)
+		}
+
+		// Timestamps share the same format, so they sort chronologically as strings.
+		slices.SortStableFunc(events, func(a, b pipelines.PipelineEvent) int {
+			return strings.Compare(a.Timestamp, b.Timestamp)
+		})
+
+		result = append(result, PipelineUpdate{
+			UpdateId:     update.UpdateId,
+			CreationTime: time.UnixMilli(update.CreationTime).UTC(),
+			State:        string(update.State),
+			Events:       events,
+		})
+	}
+	return result, nil
+}
+
+func selectPipelineUpdates(ctx context.Context, w *databricks.WorkspaceClient, pipelineId string, opts Options) ([]pipelines.UpdateInfo, error) {
+	if opts.RunId != "" {
+		resp, err := w.Pipelines.GetUpdate(ctx, pipelines.GetUpdateRequest{
+			PipelineId: pipelineId,
+			UpdateId:   opts.RunId,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if resp.Update == nil {
+			return nil, fmt.Errorf("update %s of pipeline %s not found", opts.RunId, pipelineId)
+		}
+		return []pipelines.UpdateInfo{*resp.Update}, nil
+	}
+
+	// Updates are listed in descending order by creation time.
+	var updates []pipelines.UpdateInfo
+	req := pipelines.ListUpdatesRequest{
+		PipelineId: pipelineId,
+	}
+	for {
+		resp, err := w.Pipelines.ListUpdates(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		for _, update := range resp.Updates {
+			// Skip updates that are still in progress, as for jobs.
+			if !isUpdateCompleted(update.State) {
+				continue
+			}
+			if opts.Failed && update.State != pipelines.UpdateInfoStateFailed {
+				continue
+			}
+			updates = append(updates, update)
+			if len(updates) == opts.Last {
+				return updates, nil
+			}
+		}
+		if resp.NextPageToken == "" {
+			break
+		}
+		req.PageToken = resp.NextPageToken
+	}
+
+	if len(updates) == 0 {
+		if opts.Failed {
+			return nil, fmt.Errorf("pipeline %s has no failed updates", pipelineId)
+		}
+		return nil, fmt.Errorf("pipeline %s has no completed updates", pipelineId)
+	}
+	return updates, nil
+}
+
+func isUpdateCompleted(state pipelines.UpdateInfoState) bool {
+	switch state {
+	case pipelines.UpdateInfoStateCompleted, pipelines.UpdateInfoStateFailed, pipelines.UpdateInfoStateCanceled:
+		return true
+	default:
+		return false
+	}
+}
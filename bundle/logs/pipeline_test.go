@@ -0,0 +1,109 @@
+package logs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/databricks/databricks-sdk-go/experimental/mocks"
+	"github.com/databricks/databricks-sdk-go/service/pipelines"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPipelineUpdatesFailed(t *testing.T) {
+	ctx := context.Background()
+	m := mocks.NewMockWorkspaceClient(t)
+	pipelineApi := m.GetMockPipelinesAPI()
+
+	pipelineApi.EXPECT().ListUpdates(mock.Anything, pipelines.ListUpdatesRequest{
+		PipelineId: "p1",
+	}).Return(&pipelines.ListUpdatesResponse{
+		Updates: []pipelines.UpdateInfo{
+			{UpdateId: "u3", State: pipelines.UpdateInfoStateCompleted},
+		},
+		NextPageToken: "next",
+	}, nil)
+	pipelineApi.EXPECT().ListUpdates(mock.Anything, pipelines.ListUpdatesRequest{
+		PipelineId: "p1",
+		PageToken:  "next",
+	}).Return(&pipelines.ListUpdatesResponse{
+		Updates: []pipelines.UpdateInfo{
+			{UpdateId: "u2", State: pipelines.UpdateInfoStateFailed},
+			{UpdateId: "u1", State: pipelines.UpdateInfoStateFailed},
+		},
+	}, nil)
+
+	pipelineApi.EXPECT().ListPipelineEventsAll(mock.Anything, pipelines.ListPipelineEventsRequest{
+		PipelineId: "p1",
+		Filter:     "update_id = 'u2'",
+	}).Return([]pipelines.PipelineEvent{
+		{Timestamp: "2025-01-15T10:30:02.000Z", Message: "Update failed"},
+		{Timestamp: "2025-01-15T10:30:01.000Z", Message: "Update started"},
+	}, nil)
+
+	result, err := PipelineUpdates(ctx, m.WorkspaceClient, "p1", Options{Last: 1, Failed: true})
+	require.NoError(t, err)
+	require.Len(t, result, 1)
+	assert.Equal(t, "u2", result[0].UpdateId)
+	assert.Equal(t, "FAILED", result[0].State)
+	assert.Equal(t, []pipelines.PipelineEvent{
+		{Timestamp: "2025-01-15T10:30:01.000Z", Message: "Update started"},
+		{Timestamp: "2025-01-15T10:30:02.000Z", Message: "Update failed"},
+	}, result[0].Events)
+}
+
+func TestPipelineUpdatesRunId(t *testing.T) {
+	ctx := context.Background()
+	m := mocks.NewMockWorkspaceClient(t)
+	pipelineApi := m.GetMockPipelinesAPI()
+
+	pipelineApi.EXPECT().GetUpdate(mock.Anything, pipelines.GetUpdateRequest{
+		PipelineId: "p1",
+		UpdateId:   "u1",
+	}).Return(&pipelines.GetUpdateResponse{
+		Update: &pipelines.UpdateInfo{UpdateId: "u1", State: pipelines.UpdateInfoStateCompleted},
+	}, nil)
+	pipelineApi.EXPECT().ListPipelineEventsAll(mock.Anything, pipelines.ListPipelineEventsRequest{
+		PipelineId: "p1",
+		Filter:     "update_id = 'u1'",
+	}).Return(nil, nil)
+
+	result, err := PipelineUpdates(ctx, m.WorkspaceClient, "p1", Options{RunId: "u1"})
+	require.NoError(t, err)
+	require.Len(t, result, 1)
+	assert.Equal(t, "u1", result[0].UpdateId)
+	assert.Equal(t, "COMPLETED", result[0].State)
+}
+
+func TestPipelineUpdatesNoUpdates(t *testing.T) {
+	ctx := context.Background()
+	m := mocks.NewMockWorkspaceClient(t)
+
+	m.GetMockPipelinesAPI().EXPECT().ListUpdates(mock.Anything, mock.Anything).Return(&pipelines.ListUpdatesResponse{}, nil)
+
+	_, err := PipelineUpdates(ctx, m.WorkspaceClient, "p1", Options{Last: 1})
+	assert.EqualError(t, err, "pipeline p1 has no completed updates")
+}
+
+func TestPipelineUpdatesSkipsActiveUpdates(t *testing.T) {
+	ctx := context.Background()
+	m := mocks.NewMockWorkspaceClient(t)
+	pipelineApi := m.GetMockPipelinesAPI()
+
+	pipelineApi.EXPECT().ListUpdates(mock.Anything, pipelines.ListUpdatesRequest{
+		PipelineId: "p1",
+	}).Return(&pipelines.ListUpdatesResponse{
+		Updates: []pipelines.UpdateInfo{
+			{UpdateId: "u3", State: pipelines.UpdateInfoStateRunning},
+			{UpdateId: "u2", State: pipelines.UpdateInfoStateCanceled},
+			{UpdateId: "u1", State: pipelines.UpdateInfoStateCompleted},
+		},
+	}, nil)
+	pipelineApi.EXPECT().ListPipelineEventsAll(mock.Anything, mock.Anything).Return(nil, nil)
+
+	result, err := PipelineUpdates(ctx, m.WorkspaceClient, "p1", Options{Last: 1})
+	require.NoError(t, err)
+	require.Len(t, result, 1)
+	assert.Equal(t, "u2", result[0].UpdateId)
+}
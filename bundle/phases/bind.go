@@ -2,11 +2,16 @@ package phases
 
 import (
 	"context"
+	"errors"
+	"strings"
 
 	"github.com/databricks/cli/bundle"
 	"github.com/databricks/cli/bundle/deploy/lock"
 	"github.com/databricks/cli/bundle/deploy/terraform"
+	"github.com/databricks/cli/bundle/render"
 	"github.com/databricks/cli/bundle/statemgmt"
+	"github.com/databricks/cli/bundle/terranova"
+	"github.com/databricks/cli/libs/cmdio"
 	"github.com/databricks/cli/libs/log"
 	"github.com/databricks/cli/libs/logdiag"
 )
@@ -32,6 +37,67 @@ func Bind(ctx context.Context, b *bundle.Bundle, opts *terraform.BindOptions) {
 	)
 }
 
+// BindDirect records existing workspace resources in the state of the direct deployment engine.
+// It shows what the next deployment would change on the bound resources and asks for confirmation
+// before saving the state, unless autoApprove is set.
+func BindDirect(ctx context.Context, b *bundle.Bundle, bindings []terranova.Binding, autoApprove bool) {
+	log.Info(ctx, "Phase: bind")
+
+	bundle.ApplyContext(ctx, b, lock.Acquire())
+	if logdiag.HasError(ctx) {
+		return
+	}
+
+	defer func() {
+		bundle.ApplyContext(ctx, b, lock.Release(lock.GoalBind))
+	}()
+
+	bundle.ApplyContext(ctx, b, statemgmt.StatePull())
+	if logdiag.HasError(ctx) {
+		return
+	}
+
+	actions, err := terranova.Bind(ctx, b, bindings)
+	if err != nil {
+		logdiag.LogError(ctx, err)
+		return
+	}
+
+	if len(actions) > 0 && !autoApprove {
+		var buf strings.Builder
+		err = render.RenderPlan(&buf, actions)
+		if err != nil {
+			logdiag.LogError(ctx, err)
+			return
+		}
+		cmdio.LogString(ctx, "The next deployment will make the following changes to the bound resources:")
+		cmdio.LogString(ctx, buf.String())
+
+		if !cmdio.IsPromptSupported(ctx) {
+			logdiag.LogError(ctx, errors.New("this bind operation requires user confirmation, but the current console does not support prompting. Please specify --auto-approve if you would like to skip prompts and proceed"))
+			return
+		}
+
+		ans, err := cmdio.AskYesOrNo(ctx, "Confirm import changes? Changes will be remotely applied only after running 'bundle deploy'.")
+		if err != nil {
+			logdiag.LogError(ctx, err)
+			return
+		}
+		if !ans {
+			logdiag.LogError(ctx, errors.New("import aborted"))
+			return
+		}
+	}
+
+	err = b.ResourceDatabase.Finalize()
+	if err != nil {
+		logdiag.LogError(ctx, err)
+		return
+	}
+
+	bundle.ApplyContext(ctx, b, statemgmt.StatePush())
+}
+
 func Unbind(ctx context.Context, b *bundle.Bundle, resourceType, resourceKey string) {
 	log.Info(ctx, "Phase: unbind")
 
@@ -3,6 +3,7 @@ package phases
 import (
 	"context"
 	"errors"
+	"strings"
 
 	"github.com/databricks/cli/bundle"
 	"github.com/databricks/cli/bundle/apps"
@@ -23,6 +24,7 @@ import (
 	"github.com/databricks/cli/bundle/terranova"
 	"github.com/databricks/cli/bundle/trampoline"
 	"github.com/databricks/cli/libs/cmdio"
+	"github.com/databricks/cli/libs/diag"
 	"github.com/databricks/cli/libs/log"
 	"github.com/databricks/cli/libs/logdiag"
 	"github.com/databricks/cli/libs/sync"
@@ -30,9 +32,12 @@ import (
 
 func getActions(ctx context.Context, b *bundle.Bundle) ([]deployplan.Action, error) {
 	if b.DirectDeployment {
-		err := terranova.CalculatePlanForDeploy(ctx, b)
-		if err != nil {
-			return nil, err
+		// A saved plan is loaded by Deploy before anything is uploaded
+		if b.SavedPlan == nil {
+			err := terranova.CalculatePlanForDeploy(ctx, b)
+			if err != nil {
+				return nil, err
+			}
 		}
 		return terranova.GetDeployActions(ctx, b), nil
 	} else {
@@ -114,6 +119,14 @@ func approvalForDeploy(ctx context.Context, b *bundle.Bundle) (bool, error) {
 func deployCore(ctx context.Context, b *bundle.Bundle) {
 	// Core mutators that CRUD resources and modify deployment state. These
 	// mutators need informed consent if they are potentially destructive.
+	if len(b.OnlyResources) > 0 {
+		logdiag.LogDiag(ctx, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "This is a partial deployment: only resources matching " + strings.Join(b.OnlyResources, ", ") + " and the resources they reference are deployed",
+			Detail:   "Other resources are left as they were after the last deployment. Run \"bundle deploy\" without --only to deploy all resources.",
+		})
+	}
+
 	cmdio.LogString(ctx, "Deploying resources...")
 
 	if b.DirectDeployment {
@@ -202,6 +215,15 @@ func Deploy(ctx context.Context, b *bundle.Bundle, outputHandler sync.OutputHand
 		return
 	}
 
+	// Refuse to apply a saved plan if the state has changed since, before any files are uploaded
+	if b.SavedPlan != nil {
+		err := terranova.LoadPlan(ctx, b, b.SavedPlan)
+		if err != nil {
+			logdiag.LogError(ctx, err)
+			return
+		}
+	}
+
 	bundle.ApplySeqContext(ctx, b,
 		files.Upload(outputHandler),
 		deploy.StateUpdate(),
@@ -272,6 +294,25 @@ func Diff(ctx context.Context, b *bundle.Bundle) []deployplan.Action {
 	return actions
 }
 
+// SavePlan writes the plan calculated by Diff to path, so that it can be applied later with "bundle deploy --plan".
+func SavePlan(ctx context.Context, b *bundle.Bundle, path string) {
+	if !b.DirectDeployment {
+		logdiag.LogError(ctx, errors.New("saving a plan is only supported by the direct deployment engine"))
+		return
+	}
+
+	plan, err := terranova.GetPlan(ctx, b)
+	if err != nil {
+		logdiag.LogError(ctx, err)
+		return
+	}
+
+	err = plan.Save(path)
+	if err != nil {
+		logdiag.LogError(ctx, err)
+	}
+}
+
 // If there are more than 1 thousand of a resource type, do not
 // include more resources.
 // Since we have a timeout of 3 seconds, we cap the maximum number of IDs
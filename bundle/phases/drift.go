@@ -0,0 +1,37 @@
+package phases
+
+import (
+	"context"
+	"errors"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/deployplan"
+	"github.com/databricks/cli/bundle/statemgmt"
+	"github.com/databricks/cli/bundle/terranova"
+	"github.com/databricks/cli/libs/log"
+	"github.com/databricks/cli/libs/logdiag"
+)
+
+// Drift compares the deployed resources with their remote settings and returns
+// the resources that were changed or deleted outside of the bundle.
+func Drift(ctx context.Context, b *bundle.Bundle) []deployplan.Drift {
+	log.Info(ctx, "Phase: drift")
+
+	if !b.DirectDeployment {
+		logdiag.LogError(ctx, errors.New("drift detection is only supported by the direct deployment engine"))
+		return nil
+	}
+
+	bundle.ApplyContext(ctx, b, statemgmt.StatePull())
+	if logdiag.HasError(ctx) {
+		return nil
+	}
+
+	drifts, err := terranova.CalculateDrift(ctx, b)
+	if err != nil {
+		logdiag.LogError(ctx, err)
+		return nil
+	}
+
+	return drifts
+}
@@ -0,0 +1,67 @@
+package phases
+
+import (
+	"context"
+	"errors"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/deploy/lock"
+	"github.com/databricks/cli/bundle/deploy/terraform"
+	"github.com/databricks/cli/bundle/statemgmt"
+	"github.com/databricks/cli/bundle/terranova"
+	"github.com/databricks/cli/libs/log"
+	"github.com/databricks/cli/libs/logdiag"
+)
+
+// Migrate converts the Terraform deployment state of the bundle into the state of the direct deployment engine
+// and pushes it to the workspace. The Terraform state is left in place.
+// It leaves the bundle configured for the direct deployment engine.
+func Migrate(ctx context.Context, b *bundle.Bundle) {
+	log.Info(ctx, "Phase: migrate")
+
+	bundle.ApplyContext(ctx, b, lock.Acquire())
+	if logdiag.HasError(ctx) {
+		return
+	}
+
+	defer func() {
+		bundle.ApplyContext(ctx, b, lock.Release(lock.GoalMigrate))
+	}()
+
+	// Read the Terraform state first; state file names and paths depend on the deployment engine.
+	b.DirectDeployment = false
+
+	bundle.ApplyContext(ctx, b, statemgmt.StatePull())
+	if logdiag.HasError(ctx) {
+		return
+	}
+
+	state, err := terraform.ParseResourcesState(ctx, b)
+	if err != nil {
+		logdiag.LogError(ctx, err)
+		return
+	}
+
+	lineage, serial, err := terraform.ParseStateLineage(ctx, b)
+	if err != nil {
+		logdiag.LogError(ctx, err)
+		return
+	}
+
+	if lineage == "" {
+		logdiag.LogError(ctx, errors.New("no Terraform deployment state found. Did you forget to run 'databricks bundle deploy'?"))
+		return
+	}
+
+	// Migration has no side effects in the workspace other than pushing the new state:
+	// the saved state is read from the workspace, so the preparation steps of a deployment, such as uploading libraries, are not needed.
+	b.DirectDeployment = true
+
+	err = terranova.Migrate(ctx, b, state, lineage, serial)
+	if err != nil {
+		logdiag.LogError(ctx, err)
+		return
+	}
+
+	bundle.ApplyContext(ctx, b, statemgmt.StatePush())
+}
@@ -0,0 +1,81 @@
+package phases
+
+import (
+	"context"
+	"path/filepath"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/config"
+	"github.com/databricks/cli/bundle/deploy/files"
+	"github.com/databricks/cli/bundle/deploy/lock"
+	"github.com/databricks/cli/bundle/testrun"
+	"github.com/databricks/cli/libs/log"
+	"github.com/databricks/cli/libs/logdiag"
+	"github.com/databricks/cli/libs/sync"
+)
+
+// Test uploads the bundle files to the workspace and runs the tests among them with pytest.
+// Resources are not deployed.
+func Test(ctx context.Context, b *bundle.Bundle, opts testrun.Options) *testrun.Result {
+	log.Info(ctx, "Phase: test")
+
+	bundle.ApplyContext(ctx, b, lock.Acquire())
+	if logdiag.HasError(ctx) {
+		return nil
+	}
+
+	defer func() {
+		bundle.ApplyContext(ctx, b, lock.Release(lock.GoalTest))
+	}()
+
+	bundle.ApplyContext(ctx, b, files.Upload(nil))
+	if logdiag.HasError(ctx) {
+		return nil
+	}
+
+	root, fileList, err := syncedFiles(ctx, b)
+	if err != nil {
+		logdiag.LogError(ctx, err)
+		return nil
+	}
+
+	result, err := testrun.Run(ctx, b, root, fileList, opts)
+	if err != nil {
+		logdiag.LogError(ctx, err)
+		return nil
+	}
+
+	return result
+}
+
+// syncedFiles returns the workspace directory that the bundle files are synchronized to, and the files in it.
+func syncedFiles(ctx context.Context, b *bundle.Bundle) (string, []string, error) {
+	root := b.Config.Workspace.FilePath
+	fileset := b.Files
+
+	// With source-linked deployment the files are not uploaded; the tests run on the source files in the workspace.
+	if config.IsExplicitlyEnabled(b.Config.Presets.SourceLinkedDeployment) {
+		root = b.SyncRootPath
+
+		opts, err := files.GetSyncOptions(ctx, b)
+		if err != nil {
+			return "", nil, err
+		}
+		s, err := sync.New(ctx, *opts)
+		if err != nil {
+			return "", nil, err
+		}
+		defer s.Close()
+
+		fileset, err = s.GetFileList(ctx)
+		if err != nil {
+			return "", nil, err
+		}
+	}
+
+	var result []string
+	for _, f := range fileset {
+		result = append(result, filepath.ToSlash(f.Relative))
+	}
+	return root, result, nil
+}
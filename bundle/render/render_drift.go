@@ -0,0 +1,47 @@
+package render
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/databricks/cli/bundle/deployplan"
+)
+
+// RenderDrift writes one line per resource that was changed or deleted outside of the bundle,
+// followed by the fields that differ from the deployed values.
+//
+// Example:
+//
+//	drift jobs.foo
+//	  ~ .name: "deployed name" -> "name in workspace"
+//	deleted schemas.bar
+func RenderDrift(out io.Writer, drifts []deployplan.Drift) error {
+	if len(drifts) == 0 {
+		_, err := fmt.Fprintln(out, "No drift detected")
+		return err
+	}
+
+	for _, drift := range drifts {
+		kind := "drift"
+		if drift.Deleted {
+			kind = "deleted"
+		}
+
+		_, err := fmt.Fprintf(out, "%s %s.%s\n", kind, drift.Group, drift.Key)
+		if err != nil {
+			return err
+		}
+
+		for _, change := range drift.Changes {
+			line, err := renderFieldChange(change)
+			if err != nil {
+				return fmt.Errorf("%s.%s: %w", drift.Group, drift.Key, err)
+			}
+			_, err = fmt.Fprintf(out, "  %s\n", line)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
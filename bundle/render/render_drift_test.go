@@ -0,0 +1,54 @@
+package render
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/databricks/cli/bundle/deployplan"
+	"github.com/fatih/color"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderDrift(t *testing.T) {
+	// Disable colors for consistent test output
+	oldNoColor := color.NoColor
+	color.NoColor = true
+	defer func() {
+		color.NoColor = oldNoColor
+	}()
+
+	drifts := []deployplan.Drift{
+		{
+			ResourceNode: deployplan.ResourceNode{Group: "jobs", Key: "foo"},
+			ID:           "123",
+			Changes: []deployplan.FieldChange{
+				{Path: ".name", Old: "deployed", New: "changed"},
+				{Path: ".tags", Old: map[string]string{"env": "dev"}, New: nil},
+			},
+		},
+		{
+			ResourceNode: deployplan.ResourceNode{Group: "schemas", Key: "bar"},
+			ID:           "main.bar",
+			Deleted:      true,
+		},
+	}
+
+	writer := &bytes.Buffer{}
+	err := RenderDrift(writer, drifts)
+	require.NoError(t, err)
+
+	expected := `drift jobs.foo
+  ~ .name: "deployed" -> "changed"
+  - .tags: {"env":"dev"}
+deleted schemas.bar
+`
+	assert.Equal(t, expected, writer.String())
+}
+
+func TestRenderDriftEmpty(t *testing.T) {
+	writer := &bytes.Buffer{}
+	err := RenderDrift(writer, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "No drift detected\n", writer.String())
+}
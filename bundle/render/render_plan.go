@@ -0,0 +1,76 @@
+package render
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/databricks/cli/bundle/deployplan"
+	"github.com/fatih/color"
+)
+
+// RenderPlan writes one line per planned action followed by the fields it changes.
+//
+// Example:
+//
+//	update jobs.foo
+//	  ~ .trigger.periodic.unit: "DAYS" -> "HOURS"
+//	recreate schemas.bar
+//	  ~ .catalog_name: "main" -> "dev" (forces recreate)
+func RenderPlan(out io.Writer, actions []deployplan.Action) error {
+	for _, action := range actions {
+		_, err := fmt.Fprintf(out, "%s %s.%s\n", action.ActionType, action.Group, action.Key)
+		if err != nil {
+			return err
+		}
+
+		for _, change := range action.Changes {
+			line, err := renderFieldChange(change)
+			if err != nil {
+				return fmt.Errorf("%s.%s: %w", action.Group, action.Key, err)
+			}
+			_, err = fmt.Fprintf(out, "  %s\n", line)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func renderFieldChange(change deployplan.FieldChange) (string, error) {
+	oldValue, err := renderValue(change.Old)
+	if err != nil {
+		return "", err
+	}
+	newValue, err := renderValue(change.New)
+	if err != nil {
+		return "", err
+	}
+
+	var line string
+	switch {
+	case oldValue == "null":
+		line = color.GreenString("+ %s: %s", change.Path, newValue)
+	case newValue == "null":
+		line = color.RedString("- %s: %s", change.Path, oldValue)
+	default:
+		line = color.YellowString("~ %s: %s -> %s", change.Path, oldValue, newValue)
+	}
+
+	if change.Recreate {
+		line += " " + color.RedString("(forces recreate)")
+	}
+
+	return line, nil
+}
+
+// renderValue formats a field value as compact JSON, so that strings are quoted
+// and nested values keep the field names used in the configuration.
+func renderValue(v any) (string, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
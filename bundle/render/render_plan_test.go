@@ -0,0 +1,66 @@
+package render
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/databricks/cli/bundle/deployplan"
+	"github.com/fatih/color"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderPlan(t *testing.T) {
+	// Disable colors for consistent test output
+	oldNoColor := color.NoColor
+	color.NoColor = true
+	defer func() {
+		color.NoColor = oldNoColor
+	}()
+
+	var nilTags map[string]string
+
+	actions := []deployplan.Action{
+		{
+			ResourceNode: deployplan.ResourceNode{Group: "jobs", Key: "foo"},
+			ActionType:   deployplan.ActionTypeCreate,
+		},
+		{
+			ResourceNode: deployplan.ResourceNode{Group: "jobs", Key: "bar"},
+			ActionType:   deployplan.ActionTypeUpdate,
+			Changes: []deployplan.FieldChange{
+				{Path: ".name", Old: "old", New: "new"},
+				{Path: ".max_concurrent_runs", Old: 1, New: 2},
+				{Path: ".tags", Old: nilTags, New: map[string]string{"env": "dev"}},
+				{Path: ".description", Old: "text", New: nil},
+			},
+		},
+		{
+			ResourceNode: deployplan.ResourceNode{Group: "schemas", Key: "baz"},
+			ActionType:   deployplan.ActionTypeRecreate,
+			Changes: []deployplan.FieldChange{
+				{Path: ".catalog_name", Old: "main", New: "dev", Recreate: true},
+			},
+		},
+		{
+			ResourceNode: deployplan.ResourceNode{Group: "models", Key: "qux"},
+			ActionType:   deployplan.ActionTypeUpdateWithID,
+		},
+	}
+
+	writer := &bytes.Buffer{}
+	err := RenderPlan(writer, actions)
+	require.NoError(t, err)
+
+	expected := `create jobs.foo
+update jobs.bar
+  ~ .name: "old" -> "new"
+  ~ .max_concurrent_runs: 1 -> 2
+  + .tags: {"env":"dev"}
+  - .description: "text"
+recreate schemas.baz
+  ~ .catalog_name: "main" -> "dev" (forces recreate)
+update models.qux
+`
+	assert.Equal(t, expected, writer.String())
+}
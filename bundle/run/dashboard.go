@@ -0,0 +1,67 @@
+package run
+
+import (
+	"context"
+	"errors"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/config/resources"
+	"github.com/databricks/cli/bundle/run/output"
+	"github.com/databricks/databricks-sdk-go/service/dashboards"
+)
+
+type dashboardRunner struct {
+	key
+	nopArgsHandler
+
+	bundle    *bundle.Bundle
+	dashboard *resources.Dashboard
+}
+
+func (r *dashboardRunner) Name() string {
+	if r.dashboard == nil {
+		return ""
+	}
+	return r.dashboard.DisplayName
+}
+
+// Run publishes the latest draft of the dashboard. This refreshes the published
+// dashboard with the latest definition and data.
+// Publishing completes synchronously, so there is nothing to wait for.
+func (r *dashboardRunner) Run(ctx context.Context, opts *Options) (output.RunOutput, error) {
+	dashboard := r.dashboard
+	if dashboard == nil {
+		return nil, errors.New("dashboard is not defined")
+	}
+	if dashboard.ID == "" {
+		return nil, errors.New("dashboard has not been deployed yet")
+	}
+
+	w := r.bundle.WorkspaceClient()
+
+	logProgress(ctx, "Publishing the dashboard "+dashboard.DisplayName)
+	published, err := w.Lakeview.Publish(ctx, dashboards.PublishRequest{
+		DashboardId:      dashboard.ID,
+		EmbedCredentials: dashboard.EmbedCredentials,
+		WarehouseId:      dashboard.WarehouseId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	logProgress(ctx, "Dashboard is published!")
+	return &output.DashboardOutput{
+		DashboardId:        dashboard.ID,
+		RevisionCreateTime: published.RevisionCreateTime,
+		Url:                dashboard.URL,
+	}, nil
+}
+
+// Cancel is a no-op because publishing a dashboard completes synchronously.
+func (r *dashboardRunner) Cancel(ctx context.Context) error {
+	return nil
+}
+
+func (r *dashboardRunner) Restart(ctx context.Context, opts *Options) (output.RunOutput, error) {
+	return r.Run(ctx, opts)
+}
@@ -0,0 +1,65 @@
+package run
+
+import (
+	"context"
+	"testing"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/config"
+	"github.com/databricks/cli/bundle/config/resources"
+	"github.com/databricks/cli/bundle/run/output"
+	"github.com/databricks/cli/libs/cmdio"
+	"github.com/databricks/databricks-sdk-go/experimental/mocks"
+	"github.com/databricks/databricks-sdk-go/service/dashboards"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDashboardRunnerRun(t *testing.T) {
+	dashboard := &resources.Dashboard{
+		ID:  "dashboard-id",
+		URL: "https://test.com/dashboards/dashboard-id",
+		DashboardConfig: resources.DashboardConfig{
+			Dashboard: dashboards.Dashboard{
+				DisplayName: "My Dashboard",
+				WarehouseId: "warehouse-id",
+			},
+			EmbedCredentials: true,
+		},
+	}
+
+	b := &bundle.Bundle{
+		Config: config.Root{
+			Resources: config.Resources{
+				Dashboards: map[string]*resources.Dashboard{
+					"my_dashboard": dashboard,
+				},
+			},
+		},
+	}
+
+	m := mocks.NewMockWorkspaceClient(t)
+	b.SetWorkpaceClient(m.WorkspaceClient)
+
+	m.GetMockLakeviewAPI().EXPECT().Publish(mock.Anything, dashboards.PublishRequest{
+		DashboardId:      "dashboard-id",
+		EmbedCredentials: true,
+		WarehouseId:      "warehouse-id",
+	}).Return(&dashboards.PublishedDashboard{RevisionCreateTime: "2025-01-01T00:00:00Z"}, nil)
+
+	runner := dashboardRunner{key: "my_dashboard", bundle: b, dashboard: dashboard}
+	out, err := runner.Run(cmdio.MockDiscard(context.Background()), &Options{})
+	require.NoError(t, err)
+	assert.Equal(t, &output.DashboardOutput{
+		DashboardId:        "dashboard-id",
+		RevisionCreateTime: "2025-01-01T00:00:00Z",
+		Url:                "https://test.com/dashboards/dashboard-id",
+	}, out)
+}
+
+func TestDashboardRunnerRunNotDeployed(t *testing.T) {
+	runner := dashboardRunner{key: "my_dashboard", bundle: &bundle.Bundle{}, dashboard: &resources.Dashboard{}}
+	_, err := runner.Run(context.Background(), &Options{})
+	assert.EqualError(t, err, "dashboard has not been deployed yet")
+}
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/databricks/cli/bundle"
@@ -154,44 +155,53 @@ func (r *jobRunner) Run(ctx context.Context, opts *Options) (output.RunOutput, e
 		return nil, fmt.Errorf("job ID is not an integer: %s", r.job.ID)
 	}
 
-	runId := new(int64)
-
 	err = r.convertPythonParams(opts)
 	if err != nil {
 		return nil, err
 	}
 
+	// Include resource key in logger.
+	ctx = log.NewContext(ctx, log.GetLogger(ctx).With("resource", r.Key()))
+
+	if opts.Job.repair != "" {
+		return r.repair(ctx, opts, jobID)
+	}
+
 	// construct request payload from cmd line flags args
 	req, err := opts.Job.toPayload(r.job, jobID)
 	if err != nil {
 		return nil, err
 	}
 
-	// Include resource key in logger.
-	ctx = log.NewContext(ctx, log.GetLogger(ctx).With("resource", r.Key()))
+	if len(req.Only) > 0 {
+		log.Infof(ctx, "Running only these tasks: %s", strings.Join(req.Only, ", "))
+	}
 
 	w := r.bundle.WorkspaceClient()
 
-	// gets the run id from inside Jobs.RunNowAndWait
-	pullRunId := pullRunIdCallback(runId)
-
-	// callback to log status updates to the universal log destination.
-	// Called on every poll request
-	logDebug := logDebugCallback(ctx, runId)
-
-	// callback to log progress events. Called on every poll request
 	progressLogger, ok := cmdio.FromContext(ctx)
 	if !ok {
 		return nil, errors.New("no progress logger found")
 	}
-	logProgress := logProgressCallback(ctx, progressLogger)
 
 	waiter, err := w.Jobs.RunNow(ctx, *req)
 	if err != nil {
 		return nil, fmt.Errorf("cannot start job: %w", err)
 	}
 
+	return waitForRun(ctx, r, opts, progressLogger, new(int64), waiter)
+}
+
+// waitForRun waits for the job run started by the waiter to complete and returns its output.
+// Progress of the run is logged while waiting. The run ID is taken from the first poll
+// response if runId points to zero.
+func waitForRun[T any](ctx context.Context, r *jobRunner, opts *Options, progressLogger *cmdio.Logger, runId *int64, waiter *jobs.WaitGetRunJobTerminatedOrSkipped[T]) (output.RunOutput, error) {
+	w := r.bundle.WorkspaceClient()
+
 	if opts.NoWait {
+		if opts.Job.followLogs {
+			log.Warnf(ctx, "--follow-logs has no effect with --no-wait")
+		}
 		details, err := w.Jobs.GetRun(ctx, jobs.GetRunRequest{
 			RunId: waiter.RunId,
 		})
@@ -199,10 +209,30 @@ func (r *jobRunner) Run(ctx context.Context, opts *Options) (output.RunOutput, e
 		return nil, err
 	}
 
+	// gets the run id from inside Jobs.RunNowAndWait
+	pullRunId := pullRunIdCallback(runId)
+
+	// callback to log status updates to the universal log destination.
+	// Called on every poll request
+	logDebug := logDebugCallback(ctx, runId)
+
+	// callback to log progress events. Called on every poll request
+	logProgress := logProgressCallback(ctx, progressLogger)
+
+	// callback to log the output of running tasks, if requested.
+	var followLogs func(*jobs.Run)
+	if opts.Job.followLogs {
+		follower := newTaskLogFollower(w, progressLogger)
+		followLogs = func(run *jobs.Run) { follower.follow(ctx, run) }
+	}
+
 	run, err := waiter.OnProgress(func(r *jobs.Run) {
 		pullRunId(r)
 		logDebug(r)
 		logProgress(r)
+		if followLogs != nil {
+			followLogs(r)
+		}
 	}).GetWithTimeout(jobRunTimeout)
 	if err != nil {
 		r.logFailedTasks(ctx, *runId)
@@ -229,7 +259,7 @@ func (r *jobRunner) Run(ctx context.Context, opts *Options) (output.RunOutput, e
 	// The task completed successfully.
 	case jobs.RunResultStateSuccess:
 		log.Infof(ctx, "Run has completed successfully!")
-		return output.GetJobOutput(ctx, r.bundle.WorkspaceClient(), *runId)
+		return output.GetJobOutput(ctx, w, *runId)
 
 	// The run was stopped after reaching the timeout.
 	case jobs.RunResultStateTimedout:
@@ -318,6 +348,10 @@ func (r *jobRunner) Cancel(ctx context.Context) error {
 }
 
 func (r *jobRunner) Restart(ctx context.Context, opts *Options) (output.RunOutput, error) {
+	if opts.Job.repair != "" {
+		return nil, errors.New("--repair cannot be combined with --restart")
+	}
+
 	// We don't need to cancel existing runs if the job is continuous and unpaused.
 	// the /jobs/run-now API will automatically cancel any existing runs before starting a new one.
 	//
@@ -0,0 +1,296 @@
+package run
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/fs"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/databricks/cli/bundle/run/progress"
+	"github.com/databricks/cli/libs/cmdio"
+	"github.com/databricks/cli/libs/filer"
+	"github.com/databricks/cli/libs/log"
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/databricks-sdk-go/service/compute"
+	"github.com/databricks/databricks-sdk-go/service/jobs"
+)
+
+// driverLogs is the location where the driver logs of a cluster are delivered.
+type driverLogs struct {
+	clusterId string
+	filer     filer.Filer
+	dir       string
+
+	// Set if the cluster was not created for the run, e.g. an all-purpose cluster.
+	// Its logs contain output of earlier work, which is skipped.
+	preexisting bool
+}
+
+// taskLogFollower logs new output of the tasks of a job run as it becomes available.
+//
+// Tasks that run on a cluster with log delivery to DBFS or a UC volume are followed
+// by reading the delivered driver logs. Cluster logs are delivered periodically, so
+// their output lags behind. The output of all other tasks, e.g. serverless tasks,
+// is taken from the run output of the task, if the task type supports it.
+type taskLogFollower struct {
+	w              *databricks.WorkspaceClient
+	progressLogger *cmdio.Logger
+
+	// Number of bytes already logged for every log stream.
+	offsets map[string]int
+
+	// Size of every driver log file when it was last read.
+	// A file is present once it was first checked, even if it didn't exist yet.
+	sizes map[string]int64
+
+	// Driver log location of every cluster; nil if the cluster doesn't deliver its logs.
+	clusters map[string]*driverLogs
+
+	// Task runs whose run output was fully logged.
+	done map[int64]bool
+}
+
+func newTaskLogFollower(w *databricks.WorkspaceClient, progressLogger *cmdio.Logger) *taskLogFollower {
+	return &taskLogFollower{
+		w:              w,
+		progressLogger: progressLogger,
+		offsets:        make(map[string]int),
+		sizes:          make(map[string]int64),
+		clusters:       make(map[string]*driverLogs),
+		done:           make(map[int64]bool),
+	}
+}
+
+func isStarted(task jobs.RunTask) bool {
+	if task.RunId == 0 || task.State == nil {
+		return false
+	}
+	switch task.State.LifeCycleState {
+	case jobs.RunLifeCycleStatePending,
+		jobs.RunLifeCycleStateQueued,
+		jobs.RunLifeCycleStateBlocked,
+		jobs.RunLifeCycleStateSkipped:
+		return false
+	default:
+		return true
+	}
+}
+
+func isTaskTerminal(task jobs.RunTask) bool {
+	switch task.State.LifeCycleState {
+	case jobs.RunLifeCycleStateTerminated, jobs.RunLifeCycleStateInternalError:
+		return true
+	default:
+		return false
+	}
+}
+
+// follow logs the output of the tasks of the run written since the previous call.
+// Failing to fetch the output of a task doesn't fail the run, so errors are only logged.
+func (f *taskLogFollower) follow(ctx context.Context, run *jobs.Run) {
+	for _, task := range run.Tasks {
+		if !isStarted(task) || f.done[task.RunId] {
+			continue
+		}
+
+		var err error
+		if logs := f.driverLogs(ctx, task); logs != nil {
+			err = f.followDriverLogs(ctx, task, logs, logs.preexisting || sharesCluster(run, task))
+		} else {
+			err = f.followRunOutput(ctx, task)
+		}
+		if err != nil {
+			log.Debugf(ctx, "Unable to fetch the output of task %s: %s", task.TaskKey, err)
+		}
+	}
+}
+
+// sharesCluster returns true if other tasks of the run run on the same cluster as the task.
+func sharesCluster(run *jobs.Run, task jobs.RunTask) bool {
+	for _, other := range run.Tasks {
+		if other.RunId != task.RunId && other.ClusterInstance != nil && other.ClusterInstance.ClusterId == task.ClusterInstance.ClusterId {
+			return true
+		}
+	}
+	return false
+}
+
+// driverLogs returns the location of the driver logs of the cluster the task runs on.
+// It returns nil if the task doesn't run on a cluster that delivers its logs to DBFS or a UC volume.
+func (f *taskLogFollower) driverLogs(ctx context.Context, task jobs.RunTask) *driverLogs {
+	if task.ClusterInstance == nil || task.ClusterInstance.ClusterId == "" {
+		return nil
+	}
+
+	clusterId := task.ClusterInstance.ClusterId
+	if logs, ok := f.clusters[clusterId]; ok {
+		return logs
+	}
+
+	logs, err := f.lookupDriverLogs(ctx, clusterId)
+	if err != nil {
+		log.Debugf(ctx, "Unable to determine the log location of cluster %s: %s", clusterId, err)
+	}
+	f.clusters[clusterId] = logs
+	return logs
+}
+
+func (f *taskLogFollower) lookupDriverLogs(ctx context.Context, clusterId string) (*driverLogs, error) {
+	cluster, err := f.w.Clusters.GetByClusterId(ctx, clusterId)
+	if err != nil {
+		return nil, err
+	}
+
+	conf := cluster.ClusterLogConf
+	if conf == nil {
+		return nil, nil
+	}
+
+	var logsFiler filer.Filer
+	var dir string
+	switch {
+	case conf.Dbfs != nil:
+		logsFiler, err = filer.NewDbfsClient(f.w, "/")
+		dir = strings.TrimPrefix(conf.Dbfs.Destination, "dbfs:")
+	case conf.Volumes != nil:
+		logsFiler, err = filer.NewFilesClient(f.w, "/")
+		dir = conf.Volumes.Destination
+	default:
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &driverLogs{
+		clusterId:   clusterId,
+		filer:       logsFiler,
+		dir:         path.Join(dir, clusterId, "driver"),
+		preexisting: cluster.ClusterSource != compute.ClusterSourceJob,
+	}, nil
+}
+
+// followDriverLogs logs new output in the driver logs of the task's cluster.
+// Output of a cluster that is shared with other tasks or runs cannot be attributed
+// to a single task, so it is labeled with the cluster instead.
+func (f *taskLogFollower) followDriverLogs(ctx context.Context, task jobs.RunTask, logs *driverLogs, shared bool) error {
+	for _, stream := range []string{"stdout", "stderr"} {
+		p := path.Join(logs.dir, stream)
+
+		size := int64(0)
+		info, err := logs.filer.Stat(ctx, p)
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return err
+		}
+		if err == nil {
+			size = info.Size()
+		}
+
+		prevSize, seen := f.sizes[p]
+		f.sizes[p] = size
+
+		// Start at the size of the logs of a preexisting cluster when the task started,
+		// so that earlier output of the cluster isn't logged.
+		if !seen && logs.preexisting {
+			f.offsets[p] = int(size)
+			continue
+		}
+
+		// The logs haven't been delivered yet or haven't changed.
+		if size == 0 || size == prevSize {
+			continue
+		}
+
+		offset := f.offsets[p]
+		if size < int64(offset) {
+			// The log was rotated or truncated; start over.
+			offset = 0
+			f.offsets[p] = 0
+		}
+
+		b, err := readFrom(ctx, logs.filer, p, int64(offset))
+		if err != nil {
+			return err
+		}
+
+		newEvent := func(line string) cmdio.Event {
+			if shared {
+				return progress.NewClusterLogEvent(logs.clusterId, stream, line)
+			}
+			return progress.NewTaskLogEvent(task.TaskKey, stream, line)
+		}
+		f.emitChunk(p, string(b), isTaskTerminal(task), newEvent)
+	}
+	return nil
+}
+
+// readFrom reads the file at path starting at offset. It only reads the requested range
+// if the filer supports it, and reads the whole file otherwise.
+func readFrom(ctx context.Context, f filer.Filer, path string, offset int64) ([]byte, error) {
+	if rr, ok := f.(filer.RangeReader); ok {
+		r, err := rr.ReadRange(ctx, path, offset)
+		if err != nil {
+			return nil, err
+		}
+		defer r.Close()
+		return io.ReadAll(r)
+	}
+
+	r, err := f.Read(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	return b[min(offset, int64(len(b))):], nil
+}
+
+// followRunOutput logs new output in the run output of the task.
+func (f *taskLogFollower) followRunOutput(ctx context.Context, task jobs.RunTask) error {
+	out, err := f.w.Jobs.GetRunOutput(ctx, jobs.GetRunOutputRequest{
+		RunId: task.RunId,
+	})
+	if err != nil {
+		return err
+	}
+
+	final := isTaskTerminal(task)
+	f.emit(strconv.FormatInt(task.RunId, 10), out.Logs, final, func(line string) cmdio.Event {
+		return progress.NewTaskLogEvent(task.TaskKey, "logs", line)
+	})
+	f.done[task.RunId] = final
+	return nil
+}
+
+// emit logs the lines of content that were not logged before, using newEvent to create the event for every line.
+func (f *taskLogFollower) emit(key, content string, final bool, newEvent func(line string) cmdio.Event) {
+	offset := f.offsets[key]
+	if len(content) < offset {
+		// The log was rotated or truncated; start over.
+		offset = 0
+	}
+
+	f.offsets[key] = offset
+	f.emitChunk(key, content[offset:], final, newEvent)
+}
+
+// emitChunk logs the lines of chunk, which is the content that follows the offset of key.
+// Unless final is set, a trailing partial line is held back until it is complete.
+func (f *taskLogFollower) emitChunk(key, chunk string, final bool, newEvent func(line string) cmdio.Event) {
+	offset := f.offsets[key]
+	if !final {
+		i := strings.LastIndexByte(chunk, '\n')
+		chunk = chunk[:i+1]
+	}
+	f.offsets[key] = offset + len(chunk)
+
+	for line := range strings.Lines(chunk) {
+		f.progressLogger.Log(newEvent(strings.TrimSuffix(line, "\n")))
+	}
+}
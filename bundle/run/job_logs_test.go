@@ -0,0 +1,254 @@
+package run
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/databricks/cli/bundle/run/progress"
+	"github.com/databricks/cli/libs/cmdio"
+	"github.com/databricks/cli/libs/filer"
+	"github.com/databricks/cli/libs/flags"
+	"github.com/databricks/databricks-sdk-go/experimental/mocks"
+	"github.com/databricks/databricks-sdk-go/service/compute"
+	"github.com/databricks/databricks-sdk-go/service/jobs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func setupTaskLogFollower(t *testing.T) (*taskLogFollower, *mocks.MockWorkspaceClient, *bytes.Buffer) {
+	m := mocks.NewMockWorkspaceClient(t)
+	var buf bytes.Buffer
+	logger := cmdio.NewLogger(flags.ModeAppend)
+	logger.Writer = &buf
+	return newTaskLogFollower(m.WorkspaceClient, logger), m, &buf
+}
+
+func TestTaskLogFollowerEmit(t *testing.T) {
+	f, _, buf := setupTaskLogFollower(t)
+
+	// The partial line is held back until it is complete.
+	newEvent := func(line string) cmdio.Event {
+		return progress.NewTaskLogEvent("task", "stdout", line)
+	}
+
+	f.emit("key", "first\nsec", false, newEvent)
+	assert.Equal(t, "[task] first\n", buf.String())
+
+	buf.Reset()
+	f.emit("key", "first\nsecond\nthird", false, newEvent)
+	assert.Equal(t, "[task] second\n", buf.String())
+
+	// The partial line is logged once the output is final.
+	buf.Reset()
+	f.emit("key", "first\nsecond\nthird", true, newEvent)
+	assert.Equal(t, "[task] third\n", buf.String())
+
+	// Output is logged from the start if it got shorter.
+	buf.Reset()
+	f.emit("key", "new\n", false, newEvent)
+	assert.Equal(t, "[task] new\n", buf.String())
+}
+
+func TestTaskLogFollowerRunOutput(t *testing.T) {
+	f, m, buf := setupTaskLogFollower(t)
+	ctx := context.Background()
+	jobApi := m.GetMockJobsAPI()
+
+	task := jobs.RunTask{
+		TaskKey: "task",
+		RunId:   1,
+		State:   &jobs.RunState{LifeCycleState: jobs.RunLifeCycleStateRunning},
+	}
+	pending := jobs.RunTask{
+		TaskKey: "pending",
+		RunId:   2,
+		State:   &jobs.RunState{LifeCycleState: jobs.RunLifeCycleStatePending},
+	}
+
+	jobApi.EXPECT().GetRunOutput(mock.Anything, jobs.GetRunOutputRequest{RunId: 1}).Return(&jobs.RunOutput{
+		Logs: "hello\n",
+	}, nil).Once()
+	f.follow(ctx, &jobs.Run{Tasks: []jobs.RunTask{task, pending}})
+	assert.Equal(t, "[task] hello\n", buf.String())
+
+	task.State = &jobs.RunState{LifeCycleState: jobs.RunLifeCycleStateTerminated}
+	jobApi.EXPECT().GetRunOutput(mock.Anything, jobs.GetRunOutputRequest{RunId: 1}).Return(&jobs.RunOutput{
+		Logs: "hello\nworld",
+	}, nil).Once()
+	buf.Reset()
+	f.follow(ctx, &jobs.Run{Tasks: []jobs.RunTask{task}})
+	assert.Equal(t, "[task] world\n", buf.String())
+
+	// The output of a completed task is not fetched again.
+	buf.Reset()
+	f.follow(ctx, &jobs.Run{Tasks: []jobs.RunTask{task}})
+	assert.Empty(t, buf.String())
+}
+
+func TestTaskLogFollowerDriverLogs(t *testing.T) {
+	f, m, buf := setupTaskLogFollower(t)
+	ctx := context.Background()
+
+	dir := t.TempDir()
+	logsFiler, err := filer.NewLocalClient(dir)
+	require.NoError(t, err)
+	f.clusters["cluster"] = &driverLogs{clusterId: "cluster", filer: logsFiler, dir: "logs/cluster/driver"}
+
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "logs/cluster/driver"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "logs/cluster/driver/stdout"), []byte("out\n"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "logs/cluster/driver/stderr"), []byte("err\n"), 0o644))
+
+	task := jobs.RunTask{
+		TaskKey:         "task",
+		RunId:           1,
+		State:           &jobs.RunState{LifeCycleState: jobs.RunLifeCycleStateRunning},
+		ClusterInstance: &jobs.ClusterInstance{ClusterId: "cluster"},
+	}
+	f.follow(ctx, &jobs.Run{Tasks: []jobs.RunTask{task}})
+	assert.Equal(t, "[task] out\n[task] err\n", buf.String())
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "logs/cluster/driver/stdout"), []byte("out\nmore\n"), 0o644))
+	buf.Reset()
+	f.follow(ctx, &jobs.Run{Tasks: []jobs.RunTask{task}})
+	assert.Equal(t, "[task] more\n", buf.String())
+
+	// The run output isn't used for tasks on a cluster with log delivery.
+	m.GetMockJobsAPI().AssertNotCalled(t, "GetRunOutput")
+}
+
+// rangeRecorder records the offsets that files are read from.
+type rangeRecorder struct {
+	filer.Filer
+	offsets []int64
+}
+
+func (r *rangeRecorder) ReadRange(ctx context.Context, path string, offset int64) (io.ReadCloser, error) {
+	r.offsets = append(r.offsets, offset)
+	return r.Filer.(filer.RangeReader).ReadRange(ctx, path, offset)
+}
+
+// fullReader hides the ReadRange method of the filer it wraps.
+type fullReader struct {
+	filer.Filer
+}
+
+func TestTaskLogFollowerDriverLogsReadsFromOffset(t *testing.T) {
+	dir := t.TempDir()
+	localFiler, err := filer.NewLocalClient(dir)
+	require.NoError(t, err)
+	recorder := &rangeRecorder{Filer: localFiler}
+
+	for _, logsFiler := range []filer.Filer{recorder, fullReader{localFiler}} {
+		f, _, buf := setupTaskLogFollower(t)
+		ctx := context.Background()
+		f.clusters["cluster"] = &driverLogs{clusterId: "cluster", filer: logsFiler, dir: "logs/cluster/driver"}
+
+		stdout := filepath.Join(dir, "logs/cluster/driver/stdout")
+		require.NoError(t, os.MkdirAll(filepath.Dir(stdout), 0o755))
+		require.NoError(t, os.WriteFile(stdout, []byte("out\npart"), 0o644))
+
+		task := jobs.RunTask{
+			TaskKey:         "task",
+			RunId:           1,
+			State:           &jobs.RunState{LifeCycleState: jobs.RunLifeCycleStateRunning},
+			ClusterInstance: &jobs.ClusterInstance{ClusterId: "cluster"},
+		}
+		f.follow(ctx, &jobs.Run{Tasks: []jobs.RunTask{task}})
+		assert.Equal(t, "[task] out\n", buf.String())
+
+		require.NoError(t, os.WriteFile(stdout, []byte("out\npartial\n"), 0o644))
+		buf.Reset()
+		f.follow(ctx, &jobs.Run{Tasks: []jobs.RunTask{task}})
+		assert.Equal(t, "[task] partial\n", buf.String())
+
+		// The log is read from the start if it got shorter.
+		require.NoError(t, os.WriteFile(stdout, []byte("new\n"), 0o644))
+		buf.Reset()
+		f.follow(ctx, &jobs.Run{Tasks: []jobs.RunTask{task}})
+		assert.Equal(t, "[task] new\n", buf.String())
+	}
+
+	assert.Equal(t, []int64{0, 4, 0}, recorder.offsets)
+}
+
+func TestTaskLogFollowerPreexistingCluster(t *testing.T) {
+	f, _, buf := setupTaskLogFollower(t)
+	ctx := context.Background()
+
+	dir := t.TempDir()
+	logsFiler, err := filer.NewLocalClient(dir)
+	require.NoError(t, err)
+	f.clusters["cluster"] = &driverLogs{clusterId: "cluster", filer: logsFiler, dir: "logs/cluster/driver", preexisting: true}
+
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "logs/cluster/driver"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "logs/cluster/driver/stdout"), []byte("earlier\n"), 0o644))
+
+	task := jobs.RunTask{
+		TaskKey:         "task",
+		RunId:           1,
+		State:           &jobs.RunState{LifeCycleState: jobs.RunLifeCycleStateRunning},
+		ClusterInstance: &jobs.ClusterInstance{ClusterId: "cluster"},
+	}
+
+	// Output of the cluster from before the task started is skipped.
+	f.follow(ctx, &jobs.Run{Tasks: []jobs.RunTask{task}})
+	assert.Empty(t, buf.String())
+
+	// New output is labeled with the cluster, because it may come from other runs too.
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "logs/cluster/driver/stdout"), []byte("earlier\nout\n"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "logs/cluster/driver/stderr"), []byte("err\n"), 0o644))
+	f.follow(ctx, &jobs.Run{Tasks: []jobs.RunTask{task}})
+	assert.Equal(t, "[cluster cluster] out\n[cluster cluster] err\n", buf.String())
+}
+
+func TestTaskLogFollowerSharedJobCluster(t *testing.T) {
+	f, _, buf := setupTaskLogFollower(t)
+	ctx := context.Background()
+
+	dir := t.TempDir()
+	logsFiler, err := filer.NewLocalClient(dir)
+	require.NoError(t, err)
+	f.clusters["cluster"] = &driverLogs{clusterId: "cluster", filer: logsFiler, dir: "logs/cluster/driver"}
+
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "logs/cluster/driver"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "logs/cluster/driver/stdout"), []byte("out\n"), 0o644))
+
+	var tasks []jobs.RunTask
+	for i, key := range []string{"first", "second"} {
+		tasks = append(tasks, jobs.RunTask{
+			TaskKey:         key,
+			RunId:           int64(i + 1),
+			State:           &jobs.RunState{LifeCycleState: jobs.RunLifeCycleStateRunning},
+			ClusterInstance: &jobs.ClusterInstance{ClusterId: "cluster"},
+		})
+	}
+
+	// The output is logged once and labeled with the cluster, not with one of the tasks.
+	f.follow(ctx, &jobs.Run{Tasks: tasks})
+	assert.Equal(t, "[cluster cluster] out\n", buf.String())
+}
+
+func TestTaskLogFollowerClusterWithoutLogDelivery(t *testing.T) {
+	f, m, buf := setupTaskLogFollower(t)
+	ctx := context.Background()
+
+	m.GetMockClustersAPI().EXPECT().GetByClusterId(mock.Anything, "cluster").Return(&compute.ClusterDetails{}, nil).Once()
+	m.GetMockJobsAPI().EXPECT().GetRunOutput(mock.Anything, jobs.GetRunOutputRequest{RunId: 1}).Return(&jobs.RunOutput{
+		Logs: "hello\n",
+	}, nil)
+
+	task := jobs.RunTask{
+		TaskKey:         "task",
+		RunId:           1,
+		State:           &jobs.RunState{LifeCycleState: jobs.RunLifeCycleStateRunning},
+		ClusterInstance: &jobs.ClusterInstance{ClusterId: "cluster"},
+	}
+	f.follow(ctx, &jobs.Run{Tasks: []jobs.RunTask{task}})
+	f.follow(ctx, &jobs.Run{Tasks: []jobs.RunTask{task}})
+	assert.Equal(t, "[task] hello\n", buf.String())
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"regexp"
+	"slices"
 	"strconv"
 
 	"github.com/databricks/cli/bundle/config/resources"
@@ -30,11 +31,34 @@ type JobOptions struct {
 
 	// only is a list of task keys to run. If not specified, the full job is run.
 	only []string
+
+	// onlyTasks and fromTasks select the tasks to run based on the dependencies between them.
+	// Tasks in fromTasks are run together with all tasks that depend on them, directly or indirectly.
+	// The same applies to tasks in onlyTasks if withDownstream is set.
+	onlyTasks      []string
+	fromTasks      []string
+	withDownstream bool
+
+	// repair is the ID of the run to repair, or "latest" for the most recent run of the job.
+	// If not specified, a new run is started.
+	repair string
+
+	// followLogs logs the output of the tasks while the job runs.
+	followLogs bool
 }
 
+// repairLatestRun is the value of --repair if no run ID is specified.
+const repairLatestRun = "latest"
+
 func (o *JobOptions) DefineJobOptions(fs *flag.FlagSet) {
 	fs.StringToStringVar(&o.jobParams, "params", nil, "comma separated k=v pairs for job parameters")
 	fs.StringSliceVar(&o.only, "only", nil, "comma separated list of task keys to run")
+	fs.StringSliceVar(&o.onlyTasks, "only-task", nil, "task key to run (can be specified multiple times)")
+	fs.StringSliceVar(&o.fromTasks, "from-task", nil, "task key to run together with all tasks downstream of it (can be specified multiple times)")
+	fs.BoolVar(&o.withDownstream, "with-downstream", false, "also run all tasks downstream of the tasks specified with --only-task")
+	fs.StringVar(&o.repair, "repair", "", "rerun the failed and skipped tasks of the most recent run of the job, or of the run with the given ID (--repair=RUN_ID)")
+	fs.Lookup("repair").NoOptDefVal = repairLatestRun
+	fs.BoolVar(&o.followLogs, "follow-logs", false, "stream the output of running tasks, prefixed with the task key or shared cluster")
 }
 
 func (o *JobOptions) DefineTaskOptions(fs *flag.FlagSet) {
@@ -81,6 +105,26 @@ func (o *JobOptions) Validate(job *resources.Job) error {
 		return errors.New("the job to run does not define job parameters; specifying job parameters is not allowed")
 	}
 
+	if o.withDownstream && len(o.onlyTasks) == 0 {
+		return errors.New("--with-downstream requires --only-task")
+	}
+	if len(o.only) > 0 && (len(o.onlyTasks) > 0 || len(o.fromTasks) > 0) {
+		return errors.New("--only cannot be combined with --only-task or --from-task")
+	}
+	if o.repair != "" && (len(o.only) > 0 || len(o.onlyTasks) > 0 || len(o.fromTasks) > 0) {
+		return errors.New("--repair cannot be combined with --only, --only-task or --from-task")
+	}
+	if o.repair != "" && o.repair != repairLatestRun {
+		if _, err := strconv.ParseInt(o.repair, 10, 64); err != nil {
+			return fmt.Errorf("invalid run ID %#v for --repair: expected an integer", o.repair)
+		}
+	}
+	for _, task := range slices.Concat(o.onlyTasks, o.fromTasks) {
+		if !slices.ContainsFunc(job.Tasks, func(t jobs.Task) bool { return t.TaskKey == task }) {
+			return fmt.Errorf("task %#v not found in job %#v", task, job.Name)
+		}
+	}
+
 	if len(o.only) > 0 {
 		for _, task := range o.only {
 			// Skip if did not match the regex. It can mean that the more complex syntax like "task1.table1" is used.
@@ -104,6 +148,62 @@ func (o *JobOptions) Validate(job *resources.Job) error {
 	return nil
 }
 
+// ValidateRepairArgs returns an error if the ID of the run to repair is passed as a separate argument.
+// Because the run ID of --repair is optional, "--repair 123" sets --repair without a run ID, which
+// repairs the latest run, followed by the positional argument "123".
+// The arguments are the positional arguments before "--", starting with the key of the resource to run.
+func (o *JobOptions) ValidateRepairArgs(args []string) error {
+	if o.repair != repairLatestRun || len(args) < 2 {
+		return nil
+	}
+	if _, err := strconv.ParseInt(args[1], 10, 64); err != nil {
+		return nil
+	}
+	return fmt.Errorf(`the run ID must be passed as --repair=%[1]s. To pass %[1]s as an argument to the job, specify it after "--"`, args[1])
+}
+
+// selectedTasks returns the keys of the tasks selected with --only-task and --from-task,
+// in the order they are defined in the job. Downstream tasks are found by following
+// the depends_on relationships between the tasks of the job.
+func (o *JobOptions) selectedTasks(job *resources.Job) []string {
+	selected := make(map[string]bool)
+	for _, task := range o.onlyTasks {
+		selected[task] = true
+	}
+
+	// Map every task to the tasks that depend on it.
+	downstream := make(map[string][]string)
+	for _, t := range job.Tasks {
+		for _, dep := range t.DependsOn {
+			downstream[dep.TaskKey] = append(downstream[dep.TaskKey], t.TaskKey)
+		}
+	}
+
+	queue := slices.Clone(o.fromTasks)
+	if o.withDownstream {
+		queue = append(queue, o.onlyTasks...)
+	}
+	visited := make(map[string]bool)
+	for len(queue) > 0 {
+		task := queue[0]
+		queue = queue[1:]
+		if visited[task] {
+			continue
+		}
+		visited[task] = true
+		selected[task] = true
+		queue = append(queue, downstream[task]...)
+	}
+
+	var keys []string
+	for _, t := range job.Tasks {
+		if selected[t.TaskKey] {
+			keys = append(keys, t.TaskKey)
+		}
+	}
+	return keys
+}
+
 func (o *JobOptions) validatePipelineParams() (*jobs.PipelineParams, error) {
 	if len(o.pipelineParams) == 0 {
 		return nil, nil
@@ -137,6 +237,11 @@ func (o *JobOptions) toPayload(job *resources.Job, jobID int64) (*jobs.RunNow, e
 		return nil, err
 	}
 
+	only := o.only
+	if len(o.onlyTasks) > 0 || len(o.fromTasks) > 0 {
+		only = o.selectedTasks(job)
+	}
+
 	payload := &jobs.RunNow{
 		JobId: jobID,
 
@@ -150,7 +255,37 @@ func (o *JobOptions) toPayload(job *resources.Job, jobID int64) (*jobs.RunNow, e
 		SqlParams:         o.sqlParams,
 
 		JobParameters: o.jobParams,
-		Only:          o.only,
+		Only:          only,
+	}
+
+	return payload, nil
+}
+
+func (o *JobOptions) toRepairPayload(job *resources.Job, runID, latestRepairID int64, tasks []string) (*jobs.RepairRun, error) {
+	if err := o.Validate(job); err != nil {
+		return nil, err
+	}
+
+	pipelineParams, err := o.validatePipelineParams()
+	if err != nil {
+		return nil, err
+	}
+
+	payload := &jobs.RepairRun{
+		RunId:          runID,
+		LatestRepairId: latestRepairID,
+		RerunTasks:     tasks,
+
+		DbtCommands:       o.dbtCommands,
+		JarParams:         o.jarParams,
+		NotebookParams:    o.notebookParams,
+		PipelineParams:    pipelineParams,
+		PythonNamedParams: o.pythonNamedParams,
+		PythonParams:      o.pythonParams,
+		SparkSubmitParams: o.sparkSubmitParams,
+		SqlParams:         o.sqlParams,
+
+		JobParameters: o.jobParams,
 	}
 
 	return payload, nil
@@ -242,3 +242,169 @@ func TestJobOptionsValidateIfJobHasNoJobParameters(t *testing.T) {
 		assert.NoError(t, err)
 	}
 }
+
+func TestJobOptionsSelectTasks(t *testing.T) {
+	// extract -> transform -> load -> report
+	//         \-> validate
+	//             cleanup
+	job := &resources.Job{
+		JobSettings: jobs.JobSettings{
+			Name: "my_job",
+			Tasks: []jobs.Task{
+				{TaskKey: "extract"},
+				{TaskKey: "transform", DependsOn: []jobs.TaskDependency{{TaskKey: "extract"}}},
+				{TaskKey: "validate", DependsOn: []jobs.TaskDependency{{TaskKey: "extract"}}},
+				{TaskKey: "load", DependsOn: []jobs.TaskDependency{{TaskKey: "transform"}}},
+				{TaskKey: "report", DependsOn: []jobs.TaskDependency{{TaskKey: "load"}, {TaskKey: "validate"}}},
+				{TaskKey: "cleanup"},
+			},
+		},
+	}
+
+	for _, tc := range []struct {
+		args     []string
+		expected []string
+	}{
+		{
+			args:     []string{"--only-task=transform"},
+			expected: []string{"transform"},
+		},
+		{
+			args:     []string{"--only-task=transform", "--only-task=cleanup"},
+			expected: []string{"transform", "cleanup"},
+		},
+		{
+			args:     []string{"--only-task=transform", "--with-downstream"},
+			expected: []string{"transform", "load", "report"},
+		},
+		{
+			args:     []string{"--from-task=extract"},
+			expected: []string{"extract", "transform", "validate", "load", "report"},
+		},
+		{
+			args:     []string{"--from-task=validate", "--only-task=cleanup"},
+			expected: []string{"validate", "report", "cleanup"},
+		},
+	} {
+		fs, opts := setupJobOptions(t)
+		require.NoError(t, fs.Parse(tc.args))
+
+		payload, err := opts.toPayload(job, 123)
+		require.NoError(t, err)
+		assert.Equal(t, tc.expected, payload.Only, "args: %v", tc.args)
+	}
+}
+
+func TestJobOptionsSelectTasksValidation(t *testing.T) {
+	job := &resources.Job{
+		JobSettings: jobs.JobSettings{
+			Name:  "my_job",
+			Tasks: []jobs.Task{{TaskKey: "extract"}},
+		},
+	}
+
+	for _, tc := range []struct {
+		args []string
+		err  string
+	}{
+		{
+			args: []string{"--only-task=missing"},
+			err:  `task "missing" not found in job "my_job"`,
+		},
+		{
+			args: []string{"--from-task=missing"},
+			err:  `task "missing" not found in job "my_job"`,
+		},
+		{
+			args: []string{"--with-downstream"},
+			err:  "--with-downstream requires --only-task",
+		},
+		{
+			args: []string{"--only=extract", "--only-task=extract"},
+			err:  "--only cannot be combined with --only-task or --from-task",
+		},
+	} {
+		fs, opts := setupJobOptions(t)
+		require.NoError(t, fs.Parse(tc.args))
+		assert.EqualError(t, opts.Validate(job), tc.err, "args: %v", tc.args)
+	}
+}
+
+func TestJobOptionsRepair(t *testing.T) {
+	job := &resources.Job{
+		JobSettings: jobs.JobSettings{
+			Name:  "my_job",
+			Tasks: []jobs.Task{{TaskKey: "extract"}},
+		},
+	}
+
+	for _, tc := range []struct {
+		args     []string
+		expected string
+		err      string
+	}{
+		{
+			args:     []string{"--repair"},
+			expected: "latest",
+		},
+		{
+			args:     []string{"--repair=123"},
+			expected: "123",
+		},
+		{
+			args: []string{"--repair=abc"},
+			err:  `invalid run ID "abc" for --repair: expected an integer`,
+		},
+		{
+			args: []string{"--repair", "--only-task=extract"},
+			err:  "--repair cannot be combined with --only, --only-task or --from-task",
+		},
+	} {
+		fs, opts := setupJobOptions(t)
+		require.NoError(t, fs.Parse(tc.args))
+		if tc.err != "" {
+			assert.EqualError(t, opts.Validate(job), tc.err, "args: %v", tc.args)
+			continue
+		}
+		require.NoError(t, opts.Validate(job))
+		assert.Equal(t, tc.expected, opts.repair)
+	}
+}
+
+func TestJobOptionsValidateRepairArgs(t *testing.T) {
+	for _, tc := range []struct {
+		args []string
+		err  string
+	}{
+		{
+			args: []string{"my_job", "--repair", "123"},
+			err:  `the run ID must be passed as --repair=123. To pass 123 as an argument to the job, specify it after "--"`,
+		},
+		{
+			args: []string{"--repair", "my_job", "123"},
+			err:  `the run ID must be passed as --repair=123. To pass 123 as an argument to the job, specify it after "--"`,
+		},
+		{args: []string{"my_job", "--repair=123", "456"}},
+		{args: []string{"my_job", "--repair", "value"}},
+		{args: []string{"my_job", "--repair"}},
+		{args: []string{"my_job", "123"}},
+		{args: []string{"my_job", "--repair", "--", "123"}},
+	} {
+		fs := flag.NewFlagSet("run", flag.ContinueOnError)
+		var opts JobOptions
+		opts.DefineJobOptions(fs)
+		require.NoError(t, fs.Parse(tc.args))
+
+		positionalArgs := fs.Args()
+		if n := fs.ArgsLenAtDash(); n >= 0 {
+			positionalArgs = positionalArgs[:n]
+		}
+
+		err := opts.ValidateRepairArgs(positionalArgs)
+		if tc.err != "" {
+			assert.EqualError(t, err, tc.err, "args: %v", tc.args)
+		} else {
+			assert.NoError(t, err, "args: %v", tc.args)
+		}
+	}
+}
@@ -0,0 +1,134 @@
+package run
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/databricks/cli/bundle/run/output"
+	"github.com/databricks/cli/libs/cmdio"
+	"github.com/databricks/cli/libs/log"
+	"github.com/databricks/databricks-sdk-go/service/jobs"
+)
+
+// repair reruns the failed and skipped tasks of an earlier run of the job.
+func (r *jobRunner) repair(ctx context.Context, opts *Options, jobID int64) (output.RunOutput, error) {
+	w := r.bundle.WorkspaceClient()
+
+	runID, err := r.resolveRepairRunID(ctx, jobID, opts.Job.repair)
+	if err != nil {
+		return nil, err
+	}
+
+	run, err := w.Jobs.GetRun(ctx, jobs.GetRunRequest{
+		RunId: runID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if run.JobId != jobID {
+		return nil, fmt.Errorf("run %d does not belong to job %#v", runID, r.job.Name)
+	}
+	if !isTerminal(run) {
+		return nil, fmt.Errorf("run %d is still active; wait for it to complete before repairing it", runID)
+	}
+
+	tasks := tasksToRepair(run)
+	if len(tasks) == 0 {
+		return nil, fmt.Errorf("run %d has no failed or skipped tasks to repair", runID)
+	}
+
+	req, err := opts.Job.toRepairPayload(r.job, runID, latestRepairID(run), tasks)
+	if err != nil {
+		return nil, err
+	}
+
+	progressLogger, ok := cmdio.FromContext(ctx)
+	if !ok {
+		return nil, errors.New("no progress logger found")
+	}
+
+	log.Infof(ctx, "Repairing run %d, rerunning these tasks: %s", runID, strings.Join(tasks, ", "))
+	waiter, err := w.Jobs.RepairRun(ctx, *req)
+	if err != nil {
+		return nil, fmt.Errorf("cannot repair run: %w", err)
+	}
+
+	return waitForRun(ctx, r, opts, progressLogger, &runID, waiter)
+}
+
+// resolveRepairRunID returns the ID of the run to repair.
+// If no run ID was specified, it returns the ID of the most recent run of the job.
+func (r *jobRunner) resolveRepairRunID(ctx context.Context, jobID int64, repair string) (int64, error) {
+	if repair != repairLatestRun {
+		return strconv.ParseInt(repair, 10, 64)
+	}
+
+	// Runs are listed in descending order by start time.
+	it := r.bundle.WorkspaceClient().Jobs.ListRuns(ctx, jobs.ListRunsRequest{
+		JobId: jobID,
+		Limit: 1,
+	})
+	if !it.HasNext(ctx) {
+		return 0, fmt.Errorf("job %#v has no runs to repair", r.job.Name)
+	}
+	run, err := it.Next(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return run.RunId, nil
+}
+
+func isTerminal(run *jobs.Run) bool {
+	if run.State == nil {
+		return false
+	}
+	switch run.State.LifeCycleState {
+	case jobs.RunLifeCycleStateTerminated,
+		jobs.RunLifeCycleStateSkipped,
+		jobs.RunLifeCycleStateInternalError:
+		return true
+	default:
+		return false
+	}
+}
+
+// tasksToRepair returns the keys of the tasks whose latest attempt did not succeed.
+// Tasks that were excluded from the run, e.g. with --only, are not rerun.
+func tasksToRepair(run *jobs.Run) []string {
+	// A task is listed once for every attempt if the run was repaired before.
+	latest := make(map[string]jobs.RunTask)
+	var keys []string
+	for _, task := range run.Tasks {
+		prev, ok := latest[task.TaskKey]
+		if !ok {
+			keys = append(keys, task.TaskKey)
+		}
+		if !ok || task.AttemptNumber >= prev.AttemptNumber {
+			latest[task.TaskKey] = task
+		}
+	}
+
+	var tasks []string
+	for _, key := range keys {
+		task := latest[key]
+		if task.State == nil || isSuccess(task) || task.State.ResultState == jobs.RunResultStateExcluded {
+			continue
+		}
+		tasks = append(tasks, key)
+	}
+	return tasks
+}
+
+// latestRepairID returns the ID of the most recent repair of the run, or 0 if it was never repaired.
+func latestRepairID(run *jobs.Run) int64 {
+	var id int64
+	for _, item := range run.RepairHistory {
+		if item.Type == jobs.RepairHistoryItemTypeRepair {
+			id = item.Id
+		}
+	}
+	return id
+}
@@ -0,0 +1,178 @@
+package run
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/config"
+	"github.com/databricks/cli/bundle/config/resources"
+	"github.com/databricks/cli/libs/cmdio"
+	"github.com/databricks/cli/libs/flags"
+	"github.com/databricks/databricks-sdk-go/experimental/mocks"
+	"github.com/databricks/databricks-sdk-go/listing"
+	"github.com/databricks/databricks-sdk-go/service/jobs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func taskInState(key string, attempt int, lifeCycleState jobs.RunLifeCycleState, resultState jobs.RunResultState) jobs.RunTask {
+	return jobs.RunTask{
+		TaskKey:       key,
+		AttemptNumber: attempt,
+		State: &jobs.RunState{
+			LifeCycleState: lifeCycleState,
+			ResultState:    resultState,
+		},
+	}
+}
+
+func TestTasksToRepair(t *testing.T) {
+	run := &jobs.Run{
+		Tasks: []jobs.RunTask{
+			taskInState("a", 0, jobs.RunLifeCycleStateTerminated, jobs.RunResultStateSuccess),
+			taskInState("b", 0, jobs.RunLifeCycleStateTerminated, jobs.RunResultStateFailed),
+			taskInState("c", 0, jobs.RunLifeCycleStateTerminated, jobs.RunResultStateUpstreamFailed),
+			taskInState("d", 0, jobs.RunLifeCycleStateTerminated, jobs.RunResultStateExcluded),
+			taskInState("e", 0, jobs.RunLifeCycleStateTerminated, jobs.RunResultStateFailed),
+			taskInState("e", 1, jobs.RunLifeCycleStateTerminated, jobs.RunResultStateSuccess),
+		},
+	}
+	assert.Equal(t, []string{"b", "c"}, tasksToRepair(run))
+}
+
+func TestLatestRepairID(t *testing.T) {
+	assert.Equal(t, int64(0), latestRepairID(&jobs.Run{}))
+	assert.Equal(t, int64(3), latestRepairID(&jobs.Run{
+		RepairHistory: []jobs.RepairHistoryItem{
+			{Id: 1, Type: jobs.RepairHistoryItemTypeOriginal},
+			{Id: 2, Type: jobs.RepairHistoryItemTypeRepair},
+			{Id: 3, Type: jobs.RepairHistoryItemTypeRepair},
+		},
+	}))
+}
+
+func setupRepairTest(t *testing.T) (*jobRunner, *mocks.MockWorkspaceClient, context.Context) {
+	job := &resources.Job{
+		ID: "123",
+		JobSettings: jobs.JobSettings{
+			Name: "test_job",
+		},
+	}
+	b := &bundle.Bundle{
+		Config: config.Root{
+			Resources: config.Resources{
+				Jobs: map[string]*resources.Job{
+					"test_job": job,
+				},
+			},
+		},
+	}
+
+	m := mocks.NewMockWorkspaceClient(t)
+	b.SetWorkpaceClient(m.WorkspaceClient)
+
+	ctx := cmdio.MockDiscard(context.Background())
+	ctx = cmdio.NewContext(ctx, cmdio.NewLogger(flags.ModeAppend))
+	return &jobRunner{key: "test", bundle: b, job: job}, m, ctx
+}
+
+func TestJobRunnerRepairLatestRun(t *testing.T) {
+	runner, m, ctx := setupRepairTest(t)
+	jobApi := m.GetMockJobsAPI()
+
+	runs := listing.SliceIterator[jobs.BaseRun]([]jobs.BaseRun{{RunId: 456}})
+	jobApi.EXPECT().ListRuns(mock.Anything, jobs.ListRunsRequest{
+		JobId: 123,
+		Limit: 1,
+	}).Return(&runs)
+
+	jobApi.EXPECT().GetRun(mock.Anything, jobs.GetRunRequest{RunId: 456}).Return(&jobs.Run{
+		JobId: 123,
+		RunId: 456,
+		State: &jobs.RunState{LifeCycleState: jobs.RunLifeCycleStateTerminated},
+		Tasks: []jobs.RunTask{
+			taskInState("a", 0, jobs.RunLifeCycleStateTerminated, jobs.RunResultStateSuccess),
+			taskInState("b", 0, jobs.RunLifeCycleStateTerminated, jobs.RunResultStateFailed),
+		},
+		RepairHistory: []jobs.RepairHistoryItem{
+			{Id: 1, Type: jobs.RepairHistoryItemTypeOriginal},
+			{Id: 7, Type: jobs.RepairHistoryItemTypeRepair},
+		},
+	}, nil).Once()
+
+	mockWait := &jobs.WaitGetRunJobTerminatedOrSkipped[jobs.RepairRunResponse]{
+		RunId: 456,
+		Poll: func(d time.Duration, f func(*jobs.Run)) (*jobs.Run, error) {
+			return &jobs.Run{
+				State: &jobs.RunState{
+					ResultState: jobs.RunResultStateSuccess,
+				},
+			}, nil
+		},
+	}
+	jobApi.EXPECT().RepairRun(mock.Anything, jobs.RepairRun{
+		RunId:          456,
+		LatestRepairId: 7,
+		RerunTasks:     []string{"b"},
+	}).Return(mockWait, nil)
+
+	// Mock the runner getting the output of the repaired run.
+	jobApi.EXPECT().GetRun(mock.Anything, jobs.GetRunRequest{RunId: 456}).Return(&jobs.Run{}, nil).Once()
+
+	_, err := runner.Run(ctx, &Options{Job: JobOptions{repair: repairLatestRun}})
+	require.NoError(t, err)
+}
+
+func TestJobRunnerRepairRunOfOtherJob(t *testing.T) {
+	runner, m, ctx := setupRepairTest(t)
+	jobApi := m.GetMockJobsAPI()
+
+	jobApi.EXPECT().GetRun(mock.Anything, jobs.GetRunRequest{RunId: 456}).Return(&jobs.Run{
+		JobId: 789,
+		RunId: 456,
+	}, nil)
+
+	_, err := runner.Run(ctx, &Options{Job: JobOptions{repair: "456"}})
+	assert.EqualError(t, err, `run 456 does not belong to job "test_job"`)
+}
+
+func TestJobRunnerRepairActiveRun(t *testing.T) {
+	runner, m, ctx := setupRepairTest(t)
+	jobApi := m.GetMockJobsAPI()
+
+	jobApi.EXPECT().GetRun(mock.Anything, jobs.GetRunRequest{RunId: 456}).Return(&jobs.Run{
+		JobId: 123,
+		RunId: 456,
+		State: &jobs.RunState{LifeCycleState: jobs.RunLifeCycleStateRunning},
+	}, nil)
+
+	_, err := runner.Run(ctx, &Options{Job: JobOptions{repair: "456"}})
+	assert.EqualError(t, err, "run 456 is still active; wait for it to complete before repairing it")
+}
+
+func TestJobRunnerRepairSuccessfulRun(t *testing.T) {
+	runner, m, ctx := setupRepairTest(t)
+	jobApi := m.GetMockJobsAPI()
+
+	jobApi.EXPECT().GetRun(mock.Anything, jobs.GetRunRequest{RunId: 456}).Return(&jobs.Run{
+		JobId: 123,
+		RunId: 456,
+		State: &jobs.RunState{LifeCycleState: jobs.RunLifeCycleStateTerminated},
+		Tasks: []jobs.RunTask{
+			taskInState("a", 0, jobs.RunLifeCycleStateTerminated, jobs.RunResultStateSuccess),
+		},
+	}, nil)
+
+	_, err := runner.Run(ctx, &Options{Job: JobOptions{repair: "456"}})
+	assert.EqualError(t, err, "run 456 has no failed or skipped tasks to repair")
+}
+
+func TestJobRunnerRepairWithRestart(t *testing.T) {
+	runner, _, ctx := setupRepairTest(t)
+
+	_, err := runner.Restart(ctx, &Options{Job: JobOptions{repair: repairLatestRun}})
+	assert.EqualError(t, err, "--repair cannot be combined with --restart")
+}
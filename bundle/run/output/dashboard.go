@@ -0,0 +1,17 @@
+package output
+
+import "fmt"
+
+type DashboardOutput struct {
+	DashboardId        string `json:"dashboard_id"`
+	RevisionCreateTime string `json:"revision_create_time"`
+	Url                string `json:"url,omitempty"`
+}
+
+func (out *DashboardOutput) String() (string, error) {
+	s := fmt.Sprintf("Published revision: %s\n", out.RevisionCreateTime)
+	if out.Url != "" {
+		s += fmt.Sprintf("URL: %s\n", out.Url)
+	}
+	return s, nil
+}
@@ -0,0 +1,13 @@
+package output
+
+import "fmt"
+
+type QualityMonitorOutput struct {
+	TableName string `json:"table_name"`
+	RefreshId int64  `json:"refresh_id"`
+	State     string `json:"state"`
+}
+
+func (out *QualityMonitorOutput) String() (string, error) {
+	return fmt.Sprintf("Refresh ID: %d\nState: %s\n", out.RefreshId, out.State), nil
+}
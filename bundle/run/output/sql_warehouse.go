@@ -0,0 +1,12 @@
+package output
+
+import "fmt"
+
+type SqlWarehouseOutput struct {
+	WarehouseId string `json:"warehouse_id"`
+	State       string `json:"state"`
+}
+
+func (out *SqlWarehouseOutput) String() (string, error) {
+	return fmt.Sprintf("Warehouse ID: %s\nState: %s\n", out.WarehouseId, out.State), nil
+}
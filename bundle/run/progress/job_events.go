@@ -50,3 +50,44 @@ func (event *JobRunUrlEvent) String() string {
 func (event *JobRunUrlEvent) IsInplaceSupported() bool {
 	return false
 }
+
+type TaskLogEvent struct {
+	Type    string `json:"type"`
+	TaskKey string `json:"task_key,omitempty"`
+
+	// Set instead of TaskKey for output of a cluster that cannot be attributed to a single task.
+	ClusterId string `json:"cluster_id,omitempty"`
+
+	Stream string `json:"stream"`
+	Line   string `json:"line"`
+}
+
+func NewTaskLogEvent(taskKey, stream, line string) *TaskLogEvent {
+	return &TaskLogEvent{
+		Type:    "task_log",
+		TaskKey: taskKey,
+		Stream:  stream,
+		Line:    line,
+	}
+}
+
+// NewClusterLogEvent returns an event for output of a cluster that is shared by multiple tasks or runs.
+func NewClusterLogEvent(clusterId, stream, line string) *TaskLogEvent {
+	return &TaskLogEvent{
+		Type:      "task_log",
+		ClusterId: clusterId,
+		Stream:    stream,
+		Line:      line,
+	}
+}
+
+func (event *TaskLogEvent) String() string {
+	if event.TaskKey == "" {
+		return fmt.Sprintf("[cluster %s] %s", event.ClusterId, event.Line)
+	}
+	return fmt.Sprintf("[%s] %s", event.TaskKey, event.Line)
+}
+
+func (event *TaskLogEvent) IsInplaceSupported() bool {
+	return false
+}
@@ -22,3 +22,13 @@ func TestJobProgressEventString(t *testing.T) {
 	}
 	assert.Equal(t, "-0001-11-30 00:00:00 \"run_name\" TERMINATED SUCCESS state_message", event.String())
 }
+
+func TestTaskLogEventString(t *testing.T) {
+	event := NewTaskLogEvent("my_task", "stdout", "hello world")
+	assert.Equal(t, "[my_task] hello world", event.String())
+}
+
+func TestClusterLogEventString(t *testing.T) {
+	event := NewClusterLogEvent("0123-456789-abcdef", "stdout", "hello world")
+	assert.Equal(t, "[cluster 0123-456789-abcdef] hello world", event.String())
+}
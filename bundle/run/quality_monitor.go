@@ -0,0 +1,169 @@
+package run
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/config/resources"
+	"github.com/databricks/cli/bundle/run/output"
+	"github.com/databricks/cli/libs/cmdio"
+	"github.com/databricks/cli/libs/log"
+	"github.com/databricks/databricks-sdk-go/service/catalog"
+)
+
+// Interval at which the state of a quality monitor refresh is polled.
+var qualityMonitorPollInterval = 5 * time.Second
+
+type qualityMonitorRunner struct {
+	key
+	nopArgsHandler
+
+	bundle  *bundle.Bundle
+	monitor *resources.QualityMonitor
+}
+
+func (r *qualityMonitorRunner) Name() string {
+	if r.monitor == nil {
+		return ""
+	}
+	return r.monitor.TableName
+}
+
+func isRefreshActive(state catalog.MonitorRefreshInfoState) bool {
+	return state == catalog.MonitorRefreshInfoStatePending || state == catalog.MonitorRefreshInfoStateRunning
+}
+
+func (r *qualityMonitorRunner) Run(ctx context.Context, opts *Options) (output.RunOutput, error) {
+	if r.monitor == nil {
+		return nil, errors.New("quality monitor is not defined")
+	}
+
+	tableName := r.monitor.TableName
+	w := r.bundle.WorkspaceClient()
+
+	// Include resource key in logger.
+	ctx = log.NewContext(ctx, log.GetLogger(ctx).With("resource", r.Key()))
+
+	logProgress(ctx, "Refreshing the quality monitor of table "+tableName)
+	refresh, err := w.QualityMonitors.RunRefresh(ctx, catalog.RunRefreshRequest{
+		TableName: tableName,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.NoWait {
+		return &output.QualityMonitorOutput{
+			TableName: tableName,
+			RefreshId: refresh.RefreshId,
+			State:     string(refresh.State),
+		}, nil
+	}
+
+	// Poll the refresh for completion.
+	// Note: there is no "RunRefreshAndWait" wrapper for this API.
+	prevState := refresh.State
+	for isRefreshActive(refresh.State) {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(qualityMonitorPollInterval):
+		}
+
+		refresh, err = w.QualityMonitors.GetRefresh(ctx, catalog.GetRefreshRequest{
+			TableName: tableName,
+			RefreshId: refresh.RefreshId,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		// Log only if the current state is different from the previous state.
+		if refresh.State != prevState {
+			log.Infof(ctx, "Refresh status: %s", refresh.State)
+			prevState = refresh.State
+		}
+	}
+
+	if refresh.State != catalog.MonitorRefreshInfoStateSuccess {
+		if refresh.Message != "" {
+			return nil, fmt.Errorf("refresh %d of the quality monitor of table %s is %s: %s", refresh.RefreshId, tableName, refresh.State, refresh.Message)
+		}
+		return nil, fmt.Errorf("refresh %d of the quality monitor of table %s is %s", refresh.RefreshId, tableName, refresh.State)
+	}
+
+	logProgress(ctx, "Refresh has completed successfully!")
+	return &output.QualityMonitorOutput{
+		TableName: tableName,
+		RefreshId: refresh.RefreshId,
+		State:     string(refresh.State),
+	}, nil
+}
+
+// Cancel cancels all pending and running refreshes of the quality monitor
+// and waits until none of them is active anymore.
+func (r *qualityMonitorRunner) Cancel(ctx context.Context) error {
+	if r.monitor == nil {
+		return errors.New("quality monitor is not defined")
+	}
+
+	tableName := r.monitor.TableName
+	w := r.bundle.WorkspaceClient()
+
+	ctx, cancel := context.WithTimeout(ctx, jobRunTimeout)
+	defer cancel()
+
+	// Refreshes that were cancelled, but may not have stopped yet.
+	cancelled := make(map[int64]bool)
+	for {
+		refreshes, err := w.QualityMonitors.ListRefreshes(ctx, catalog.ListRefreshesRequest{
+			TableName: tableName,
+		})
+		if err != nil {
+			return err
+		}
+
+		active := false
+		for _, refresh := range refreshes.Refreshes {
+			if !isRefreshActive(refresh.State) {
+				continue
+			}
+			active = true
+			if cancelled[refresh.RefreshId] {
+				continue
+			}
+			log.Infof(ctx, "Cancelling refresh %d of the quality monitor of table %s", refresh.RefreshId, tableName)
+			err = w.QualityMonitors.CancelRefresh(ctx, catalog.CancelRefreshRequest{
+				TableName: tableName,
+				RefreshId: refresh.RefreshId,
+			})
+			if err != nil {
+				return err
+			}
+			cancelled[refresh.RefreshId] = true
+		}
+		if !active {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(qualityMonitorPollInterval):
+		}
+	}
+}
+
+func (r *qualityMonitorRunner) Restart(ctx context.Context, opts *Options) (output.RunOutput, error) {
+	s := cmdio.Spinner(ctx)
+	s <- "Cancelling active refreshes of the quality monitor"
+	err := r.Cancel(ctx)
+	close(s)
+	if err != nil {
+		return nil, err
+	}
+	return r.Run(ctx, opts)
+}
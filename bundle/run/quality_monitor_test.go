@@ -0,0 +1,137 @@
+package run
+
+import (
+	"context"
+	"testing"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/config"
+	"github.com/databricks/cli/bundle/config/resources"
+	"github.com/databricks/cli/bundle/run/output"
+	"github.com/databricks/cli/libs/cmdio"
+	"github.com/databricks/databricks-sdk-go/experimental/mocks"
+	"github.com/databricks/databricks-sdk-go/service/catalog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func setupQualityMonitorRunner(t *testing.T) (*qualityMonitorRunner, *mocks.MockWorkspaceClient) {
+	monitor := &resources.QualityMonitor{
+		TableName: "main.default.my_table",
+	}
+
+	b := &bundle.Bundle{
+		Config: config.Root{
+			Resources: config.Resources{
+				QualityMonitors: map[string]*resources.QualityMonitor{
+					"my_monitor": monitor,
+				},
+			},
+		},
+	}
+
+	m := mocks.NewMockWorkspaceClient(t)
+	b.SetWorkpaceClient(m.WorkspaceClient)
+
+	interval := qualityMonitorPollInterval
+	qualityMonitorPollInterval = 0
+	t.Cleanup(func() { qualityMonitorPollInterval = interval })
+	return &qualityMonitorRunner{key: "my_monitor", bundle: b, monitor: monitor}, m
+}
+
+func TestQualityMonitorRunnerRun(t *testing.T) {
+	runner, m := setupQualityMonitorRunner(t)
+	ctx := cmdio.MockDiscard(context.Background())
+
+	api := m.GetMockQualityMonitorsAPI()
+	api.EXPECT().RunRefresh(mock.Anything, catalog.RunRefreshRequest{
+		TableName: "main.default.my_table",
+	}).Return(&catalog.MonitorRefreshInfo{RefreshId: 1, State: catalog.MonitorRefreshInfoStatePending}, nil)
+	api.EXPECT().GetRefresh(mock.Anything, catalog.GetRefreshRequest{
+		TableName: "main.default.my_table",
+		RefreshId: 1,
+	}).Return(&catalog.MonitorRefreshInfo{RefreshId: 1, State: catalog.MonitorRefreshInfoStateRunning}, nil).Once()
+	api.EXPECT().GetRefresh(mock.Anything, catalog.GetRefreshRequest{
+		TableName: "main.default.my_table",
+		RefreshId: 1,
+	}).Return(&catalog.MonitorRefreshInfo{RefreshId: 1, State: catalog.MonitorRefreshInfoStateSuccess}, nil).Once()
+
+	out, err := runner.Run(ctx, &Options{})
+	require.NoError(t, err)
+	assert.Equal(t, &output.QualityMonitorOutput{
+		TableName: "main.default.my_table",
+		RefreshId: 1,
+		State:     "SUCCESS",
+	}, out)
+}
+
+func TestQualityMonitorRunnerRunFailed(t *testing.T) {
+	runner, m := setupQualityMonitorRunner(t)
+	ctx := cmdio.MockDiscard(context.Background())
+
+	api := m.GetMockQualityMonitorsAPI()
+	api.EXPECT().RunRefresh(mock.Anything, mock.Anything).
+		Return(&catalog.MonitorRefreshInfo{RefreshId: 2, State: catalog.MonitorRefreshInfoStatePending}, nil)
+	api.EXPECT().GetRefresh(mock.Anything, mock.Anything).
+		Return(&catalog.MonitorRefreshInfo{RefreshId: 2, State: catalog.MonitorRefreshInfoStateFailed, Message: "table not found"}, nil)
+
+	_, err := runner.Run(ctx, &Options{})
+	assert.EqualError(t, err, "refresh 2 of the quality monitor of table main.default.my_table is FAILED: table not found")
+}
+
+func TestQualityMonitorRunnerRunNoWait(t *testing.T) {
+	runner, m := setupQualityMonitorRunner(t)
+	ctx := cmdio.MockDiscard(context.Background())
+
+	api := m.GetMockQualityMonitorsAPI()
+	api.EXPECT().RunRefresh(mock.Anything, mock.Anything).
+		Return(&catalog.MonitorRefreshInfo{RefreshId: 3, State: catalog.MonitorRefreshInfoStatePending}, nil)
+
+	out, err := runner.Run(ctx, &Options{NoWait: true})
+	require.NoError(t, err)
+	assert.Equal(t, &output.QualityMonitorOutput{
+		TableName: "main.default.my_table",
+		RefreshId: 3,
+		State:     "PENDING",
+	}, out)
+}
+
+func TestQualityMonitorRunnerCancel(t *testing.T) {
+	runner, m := setupQualityMonitorRunner(t)
+	ctx := cmdio.MockDiscard(context.Background())
+
+	api := m.GetMockQualityMonitorsAPI()
+	api.EXPECT().ListRefreshes(mock.Anything, catalog.ListRefreshesRequest{
+		TableName: "main.default.my_table",
+	}).Return(&catalog.MonitorRefreshListResponse{
+		Refreshes: []catalog.MonitorRefreshInfo{
+			{RefreshId: 1, State: catalog.MonitorRefreshInfoStateSuccess},
+			{RefreshId: 2, State: catalog.MonitorRefreshInfoStateRunning},
+		},
+	}, nil).Once()
+	api.EXPECT().CancelRefresh(mock.Anything, catalog.CancelRefreshRequest{
+		TableName: "main.default.my_table",
+		RefreshId: 2,
+	}).Return(nil).Once()
+
+	// The cancelled refresh is still running; it is not cancelled again.
+	api.EXPECT().ListRefreshes(mock.Anything, catalog.ListRefreshesRequest{
+		TableName: "main.default.my_table",
+	}).Return(&catalog.MonitorRefreshListResponse{
+		Refreshes: []catalog.MonitorRefreshInfo{
+			{RefreshId: 1, State: catalog.MonitorRefreshInfoStateSuccess},
+			{RefreshId: 2, State: catalog.MonitorRefreshInfoStateRunning},
+		},
+	}, nil).Once()
+	api.EXPECT().ListRefreshes(mock.Anything, catalog.ListRefreshesRequest{
+		TableName: "main.default.my_table",
+	}).Return(&catalog.MonitorRefreshListResponse{
+		Refreshes: []catalog.MonitorRefreshInfo{
+			{RefreshId: 1, State: catalog.MonitorRefreshInfoStateSuccess},
+			{RefreshId: 2, State: catalog.MonitorRefreshInfoStateCanceled},
+		},
+	}, nil).Once()
+
+	require.NoError(t, runner.Cancel(ctx))
+}
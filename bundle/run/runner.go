@@ -42,7 +42,8 @@ type Runner interface {
 // IsRunnable returns a filter that only allows runnable resources.
 func IsRunnable(ref refs.Reference) bool {
 	switch ref.Resource.(type) {
-	case *resources.Job, *resources.Pipeline, *resources.App:
+	case *resources.Job, *resources.Pipeline, *resources.App,
+		*resources.QualityMonitor, *resources.Dashboard, *resources.SqlWarehouse:
 		return true
 	default:
 		return false
@@ -62,6 +63,12 @@ func ToRunner(b *bundle.Bundle, ref refs.Reference) (Runner, error) {
 			bundle: b,
 			app:    resource,
 		}, nil
+	case *resources.QualityMonitor:
+		return &qualityMonitorRunner{key: key(ref.KeyWithType), bundle: b, monitor: resource}, nil
+	case *resources.Dashboard:
+		return &dashboardRunner{key: key(ref.KeyWithType), bundle: b, dashboard: resource}, nil
+	case *resources.SqlWarehouse:
+		return &sqlWarehouseRunner{key: key(ref.KeyWithType), bundle: b, warehouse: resource}, nil
 	default:
 		return nil, fmt.Errorf("unsupported resource type: %T", resource)
 	}
@@ -11,6 +11,10 @@ import (
 func TestRunner_IsRunnable(t *testing.T) {
 	assert.True(t, IsRunnable(refs.Reference{Resource: &resources.Job{}}))
 	assert.True(t, IsRunnable(refs.Reference{Resource: &resources.Pipeline{}}))
+	assert.True(t, IsRunnable(refs.Reference{Resource: &resources.App{}}))
+	assert.True(t, IsRunnable(refs.Reference{Resource: &resources.QualityMonitor{}}))
+	assert.True(t, IsRunnable(refs.Reference{Resource: &resources.Dashboard{}}))
+	assert.True(t, IsRunnable(refs.Reference{Resource: &resources.SqlWarehouse{}}))
 	assert.False(t, IsRunnable(refs.Reference{Resource: &resources.MlflowModel{}}))
 	assert.False(t, IsRunnable(refs.Reference{Resource: &resources.MlflowExperiment{}}))
 }
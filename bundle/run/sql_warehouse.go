@@ -0,0 +1,106 @@
+package run
+
+import (
+	"context"
+	"errors"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/config/resources"
+	"github.com/databricks/cli/bundle/run/output"
+	"github.com/databricks/cli/libs/cmdio"
+	"github.com/databricks/databricks-sdk-go/service/sql"
+)
+
+type sqlWarehouseRunner struct {
+	key
+	nopArgsHandler
+
+	bundle    *bundle.Bundle
+	warehouse *resources.SqlWarehouse
+}
+
+func (r *sqlWarehouseRunner) Name() string {
+	if r.warehouse == nil {
+		return ""
+	}
+	return r.warehouse.Name
+}
+
+// Run starts the SQL warehouse and waits until it is running.
+func (r *sqlWarehouseRunner) Run(ctx context.Context, opts *Options) (output.RunOutput, error) {
+	warehouse := r.warehouse
+	if warehouse == nil {
+		return nil, errors.New("SQL warehouse is not defined")
+	}
+	if warehouse.ID == "" {
+		return nil, errors.New("SQL warehouse has not been deployed yet")
+	}
+
+	w := r.bundle.WorkspaceClient()
+
+	current, err := w.Warehouses.GetById(ctx, warehouse.ID)
+	if err != nil {
+		return nil, err
+	}
+	if current.State == sql.StateRunning {
+		logProgress(ctx, "SQL warehouse "+warehouse.Name+" is already running")
+		return &output.SqlWarehouseOutput{
+			WarehouseId: warehouse.ID,
+			State:       string(current.State),
+		}, nil
+	}
+
+	logProgress(ctx, "Starting the SQL warehouse "+warehouse.Name)
+	wait, err := w.Warehouses.Start(ctx, sql.StartRequest{Id: warehouse.ID})
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.NoWait {
+		return &output.SqlWarehouseOutput{
+			WarehouseId: warehouse.ID,
+			State:       string(sql.StateStarting),
+		}, nil
+	}
+
+	started, err := wait.OnProgress(func(p *sql.GetWarehouseResponse) {
+		logProgress(ctx, "SQL warehouse is "+string(p.State)+"...")
+	}).GetWithTimeout(jobRunTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	logProgress(ctx, "SQL warehouse is running!")
+	return &output.SqlWarehouseOutput{
+		WarehouseId: warehouse.ID,
+		State:       string(started.State),
+	}, nil
+}
+
+// Cancel stops the SQL warehouse and waits until it is stopped.
+func (r *sqlWarehouseRunner) Cancel(ctx context.Context) error {
+	warehouse := r.warehouse
+	if warehouse == nil {
+		return errors.New("SQL warehouse is not defined")
+	}
+
+	w := r.bundle.WorkspaceClient()
+	wait, err := w.Warehouses.Stop(ctx, sql.StopRequest{Id: warehouse.ID})
+	if err != nil {
+		return err
+	}
+
+	_, err = wait.GetWithTimeout(jobRunTimeout)
+	return err
+}
+
+func (r *sqlWarehouseRunner) Restart(ctx context.Context, opts *Options) (output.RunOutput, error) {
+	s := cmdio.Spinner(ctx)
+	s <- "Stopping the SQL warehouse"
+	err := r.Cancel(ctx)
+	close(s)
+	if err != nil {
+		return nil, err
+	}
+	return r.Run(ctx, opts)
+}
@@ -0,0 +1,95 @@
+package run
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/config"
+	"github.com/databricks/cli/bundle/config/resources"
+	"github.com/databricks/cli/bundle/run/output"
+	"github.com/databricks/cli/libs/cmdio"
+	"github.com/databricks/databricks-sdk-go/experimental/mocks"
+	"github.com/databricks/databricks-sdk-go/service/sql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func setupSqlWarehouseRunner(t *testing.T) (*sqlWarehouseRunner, *mocks.MockWorkspaceClient) {
+	warehouse := &resources.SqlWarehouse{
+		ID: "warehouse-id",
+		CreateWarehouseRequest: sql.CreateWarehouseRequest{
+			Name: "My Warehouse",
+		},
+	}
+
+	b := &bundle.Bundle{
+		Config: config.Root{
+			Resources: config.Resources{
+				SqlWarehouses: map[string]*resources.SqlWarehouse{
+					"my_warehouse": warehouse,
+				},
+			},
+		},
+	}
+
+	m := mocks.NewMockWorkspaceClient(t)
+	b.SetWorkpaceClient(m.WorkspaceClient)
+	return &sqlWarehouseRunner{key: "my_warehouse", bundle: b, warehouse: warehouse}, m
+}
+
+func TestSqlWarehouseRunnerRun(t *testing.T) {
+	runner, m := setupSqlWarehouseRunner(t)
+	ctx := cmdio.MockDiscard(context.Background())
+
+	api := m.GetMockWarehousesAPI()
+	api.EXPECT().GetById(mock.Anything, "warehouse-id").Return(&sql.GetWarehouseResponse{State: sql.StateStopped}, nil)
+	api.EXPECT().Start(mock.Anything, sql.StartRequest{Id: "warehouse-id"}).Return(&sql.WaitGetWarehouseRunning[struct{}]{
+		Poll: func(time.Duration, func(*sql.GetWarehouseResponse)) (*sql.GetWarehouseResponse, error) {
+			return &sql.GetWarehouseResponse{State: sql.StateRunning}, nil
+		},
+	}, nil)
+
+	out, err := runner.Run(ctx, &Options{})
+	require.NoError(t, err)
+	assert.Equal(t, &output.SqlWarehouseOutput{WarehouseId: "warehouse-id", State: "RUNNING"}, out)
+}
+
+func TestSqlWarehouseRunnerRunAlreadyRunning(t *testing.T) {
+	runner, m := setupSqlWarehouseRunner(t)
+	ctx := cmdio.MockDiscard(context.Background())
+
+	api := m.GetMockWarehousesAPI()
+	api.EXPECT().GetById(mock.Anything, "warehouse-id").Return(&sql.GetWarehouseResponse{State: sql.StateRunning}, nil)
+
+	out, err := runner.Run(ctx, &Options{})
+	require.NoError(t, err)
+	assert.Equal(t, &output.SqlWarehouseOutput{WarehouseId: "warehouse-id", State: "RUNNING"}, out)
+}
+
+func TestSqlWarehouseRunnerRunNoWait(t *testing.T) {
+	runner, m := setupSqlWarehouseRunner(t)
+	ctx := cmdio.MockDiscard(context.Background())
+
+	api := m.GetMockWarehousesAPI()
+	api.EXPECT().GetById(mock.Anything, "warehouse-id").Return(&sql.GetWarehouseResponse{State: sql.StateStopped}, nil)
+	api.EXPECT().Start(mock.Anything, sql.StartRequest{Id: "warehouse-id"}).Return(&sql.WaitGetWarehouseRunning[struct{}]{}, nil)
+
+	out, err := runner.Run(ctx, &Options{NoWait: true})
+	require.NoError(t, err)
+	assert.Equal(t, &output.SqlWarehouseOutput{WarehouseId: "warehouse-id", State: "STARTING"}, out)
+}
+
+func TestSqlWarehouseRunnerCancel(t *testing.T) {
+	runner, m := setupSqlWarehouseRunner(t)
+
+	m.GetMockWarehousesAPI().EXPECT().Stop(mock.Anything, sql.StopRequest{Id: "warehouse-id"}).Return(&sql.WaitGetWarehouseStopped[struct{}]{
+		Poll: func(time.Duration, func(*sql.GetWarehouseResponse)) (*sql.GetWarehouseResponse, error) {
+			return nil, nil
+		},
+	}, nil)
+
+	require.NoError(t, runner.Cancel(context.Background()))
+}
@@ -0,0 +1,57 @@
+package run
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/libs/cmdio"
+	"github.com/databricks/cli/libs/log"
+	"github.com/databricks/databricks-sdk-go/service/jobs"
+)
+
+// SubmitAndWait submits a one-time run and waits for it to complete, logging progress the same way
+// as runs of jobs defined in the bundle.
+//
+// A run that completes with a non-successful result is returned without an error,
+// so that the caller can inspect its output. Details of failed tasks are logged.
+// If onProgress is not nil, it is called every time the status of the run is polled.
+func SubmitAndWait(ctx context.Context, b *bundle.Bundle, req jobs.SubmitRun, onProgress func(*jobs.Run)) (*jobs.Run, error) {
+	w := b.WorkspaceClient()
+	runId := new(int64)
+
+	progressLogger, ok := cmdio.FromContext(ctx)
+	if !ok {
+		return nil, errors.New("no progress logger found")
+	}
+
+	pullRunId := pullRunIdCallback(runId)
+	logDebug := logDebugCallback(ctx, runId)
+	logProgress := logProgressCallback(ctx, progressLogger)
+
+	waiter, err := w.Jobs.Submit(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("cannot submit run: %w", err)
+	}
+
+	run, err := waiter.OnProgress(func(r *jobs.Run) {
+		pullRunId(r)
+		logDebug(r)
+		logProgress(r)
+		if onProgress != nil {
+			onProgress(r)
+		}
+	}).GetWithTimeout(jobRunTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	if run.State.ResultState != jobs.RunResultStateSuccess {
+		log.Infof(ctx, "Run has not completed successfully: %s", run.State.ResultState)
+		runner := &jobRunner{bundle: b}
+		runner.logFailedTasks(ctx, run.RunId)
+	}
+
+	return run, nil
+}
@@ -7,9 +7,13 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"strconv"
+	"strings"
+	"sync"
 
 	"github.com/databricks/cli/bundle"
 	"github.com/databricks/cli/bundle/deployplan"
+	"github.com/databricks/cli/bundle/env"
 	"github.com/databricks/cli/bundle/terranova/tnstate"
 	"github.com/databricks/cli/libs/diag"
 	"github.com/databricks/cli/libs/log"
@@ -20,6 +24,20 @@ import (
 // How many parallel operations (API calls) are allowed
 const defaultParallelism = 10
 
+// deployParallelism returns how many resources are deployed or destroyed concurrently.
+// Defaults to defaultParallelism and can be overridden with DATABRICKS_BUNDLE_DEPLOY_PARALLELISM.
+func deployParallelism(ctx context.Context) (int, error) {
+	value, ok := env.DeployParallelism(ctx)
+	if !ok || value == "" {
+		return defaultParallelism, nil
+	}
+	parallelism, err := strconv.Atoi(value)
+	if err != nil || parallelism < 1 {
+		return 0, fmt.Errorf("invalid value for %s: %q, expected a positive integer", env.DeployParallelismVariable, value)
+	}
+	return parallelism, nil
+}
+
 type terranovaApplyMutator struct{}
 
 func TerranovaApply() bundle.Mutator {
@@ -56,19 +74,24 @@ func (m *terranovaApplyMutator) Apply(ctx context.Context, b *bundle.Bundle) dia
 		return nil
 	}
 
+	parallelism, err := deployParallelism(ctx)
+	if err != nil {
+		logdiag.LogError(ctx, err)
+		return nil
+	}
+
 	client := b.WorkspaceClient()
 
-	b.Graph.Run(defaultParallelism, func(node deployplan.ResourceNode, failedDependency *deployplan.ResourceNode) bool {
+	// Nodes are applied concurrently; reads and writes of the bundle config are serialized with this mutex.
+	var configMu sync.Mutex
+
+	// Once any node fails, no new nodes are started, so that a failed deployment makes as few changes as possible.
+	// Nodes whose dependencies failed are never started, so failedDependency is always nil.
+	skipped := b.Graph.RunUntilFailure(parallelism, func(node deployplan.ResourceNode, _ *deployplan.ResourceNode) bool {
 		actionType := b.PlannedActions[node]
 
 		errorPrefix := fmt.Sprintf("cannot %s %s.%s", actionType.String(), node.Group, node.Key)
 
-		// If a dependency failed, report and skip execution for this node by returning false
-		if failedDependency != nil {
-			logdiag.LogError(ctx, fmt.Errorf("%s: dependency failed: %s", errorPrefix, failedDependency.String()))
-			return false
-		}
-
 		settings, ok := SupportedResources[node.Group]
 		if !ok {
 			// Unexpected, this should be filtered at plan.
@@ -98,23 +121,11 @@ func (m *terranovaApplyMutator) Apply(ctx context.Context, b *bundle.Bundle) dia
 			return true
 		}
 
-		// Fetch the references to ensure all are resolved
-		myReferences, err := extractReferences(b.Config.Value(), node)
+		configMu.Lock()
+		config, err := readResolvedConfig(b, node)
+		configMu.Unlock()
 		if err != nil {
-			logdiag.LogError(ctx, fmt.Errorf("%s: reading references from config: %w", errorPrefix, err))
-			return false
-		}
-
-		// At this point it's an error to have unresolved deps
-		if len(myReferences) > 0 {
-			// TODO: include the deps themselves in the message
-			logdiag.LogError(ctx, fmt.Errorf("%s: unresolved deps", errorPrefix))
-			return false
-		}
-
-		config, ok := b.GetResourceConfig(node.Group, node.Key)
-		if !ok {
-			logdiag.LogError(ctx, fmt.Errorf("%s: internal error when reading config", errorPrefix))
+			logdiag.LogError(ctx, fmt.Errorf("%s: %w", errorPrefix, err))
 			return false
 		}
 
@@ -128,7 +139,9 @@ func (m *terranovaApplyMutator) Apply(ctx context.Context, b *bundle.Bundle) dia
 
 		// Update resources.id after successful deploy so that future ${resources...id} refs are replaced
 		if b.Graph.HasOutgoingEdges(node) {
+			configMu.Lock()
 			err = resolveIDReference(ctx, b, node.Group, node.Key)
+			configMu.Unlock()
 			if err != nil {
 				// not using errorPrefix because resource was deployed
 				logdiag.LogError(ctx, fmt.Errorf("failed to replace ref to resources.%s.%s.id: %w", node.Group, node.Key, err))
@@ -139,8 +152,20 @@ func (m *terranovaApplyMutator) Apply(ctx context.Context, b *bundle.Bundle) dia
 		return true
 	})
 
+	var skippedNames []string
+	for _, node := range skipped {
+		actionType := b.PlannedActions[node]
+		if actionType == deployplan.ActionTypeUnset || actionType == deployplan.ActionTypeNoop {
+			continue
+		}
+		skippedNames = append(skippedNames, node.String())
+	}
+	if len(skippedNames) > 0 {
+		logdiag.LogError(ctx, fmt.Errorf("skipped %d resource(s) because of earlier errors: %s", len(skippedNames), strings.Join(skippedNames, ", ")))
+	}
+
 	// This must run even if deploy failed:
-	err := b.ResourceDatabase.Finalize()
+	err = b.ResourceDatabase.Finalize()
 	if err != nil {
 		logdiag.LogError(ctx, err)
 	}
@@ -148,6 +173,28 @@ func (m *terranovaApplyMutator) Apply(ctx context.Context, b *bundle.Bundle) dia
 	return nil
 }
 
+// readResolvedConfig returns the config of the resource, checking that all references in it have been resolved.
+func readResolvedConfig(b *bundle.Bundle, node deployplan.ResourceNode) (any, error) {
+	// Fetch the references to ensure all are resolved
+	myReferences, err := extractReferences(b.Config.Value(), node)
+	if err != nil {
+		return nil, fmt.Errorf("reading references from config: %w", err)
+	}
+
+	// At this point it's an error to have unresolved deps
+	if len(myReferences) > 0 {
+		// TODO: include the deps themselves in the message
+		return nil, errors.New("unresolved deps")
+	}
+
+	config, ok := b.GetResourceConfig(node.Group, node.Key)
+	if !ok {
+		return nil, errors.New("internal error when reading config")
+	}
+
+	return config, nil
+}
+
 type Deployer struct {
 	client       *databricks.WorkspaceClient
 	db           *tnstate.TerranovaState
@@ -197,6 +244,14 @@ func (d *Deployer) Deploy(ctx context.Context, inputConfig any, actionType deplo
 		return errors.New("invalid state: empty id")
 	}
 
+	if withSavedState, ok := resource.(IResourceSavedState); ok {
+		savedState, err := typeConvert(d.settings.ConfigType, entry.State)
+		if err != nil {
+			return fmt.Errorf("reading saved state: %w", err)
+		}
+		withSavedState.SetSavedState(savedState)
+	}
+
 	switch actionType {
 	case deployplan.ActionTypeRecreate:
 		return d.Recreate(ctx, resource, oldID, config)
@@ -208,6 +263,12 @@ func (d *Deployer) Deploy(ctx context.Context, inputConfig any, actionType deplo
 			return errors.New("internal error: plan is update_with_id but resource does not implement UpdateWithID")
 		}
 		return d.UpdateWithID(ctx, resource, updater, oldID, config)
+	case deployplan.ActionTypeResize:
+		resizer, hasResizer := resource.(IResourceResize)
+		if !hasResizer {
+			return errors.New("internal error: plan is resize but resource does not implement DoResize")
+		}
+		return d.Resize(ctx, resource, resizer, oldID, config)
 	default:
 		return fmt.Errorf("internal error: unexpected actionType: %#v", actionType)
 	}
@@ -222,7 +283,7 @@ func (d *Deployer) Create(ctx context.Context, resource IResource, config any) e
 
 	log.Infof(ctx, "Created %s.%s id=%#v", d.group, d.resourceName, newID)
 
-	err = d.db.SaveState(d.group, d.resourceName, newID, config)
+	err = d.saveState(resource, newID, config)
 	if err != nil {
 		return fmt.Errorf("saving state after creating id=%s: %w", newID, err)
 	}
@@ -241,7 +302,7 @@ func (d *Deployer) Recreate(ctx context.Context, resource IResource, oldID strin
 		return fmt.Errorf("deleting old id=%s: %w", oldID, err)
 	}
 
-	err = d.db.SaveState(d.group, d.resourceName, "", nil)
+	err = d.db.SaveState(d.group, d.resourceName, "", "", nil)
 	if err != nil {
 		return fmt.Errorf("deleting state: %w", err)
 	}
@@ -254,7 +315,7 @@ func (d *Deployer) Recreate(ctx context.Context, resource IResource, oldID strin
 	// TODO: This should be at notice level (info < notice < warn) and it should be visible by default,
 	// but to match terraform output today, we hide it (and also we don't have notice level)
 	log.Infof(ctx, "Recreated %s.%s id=%#v (previously %#v)", d.group, d.resourceName, newID, oldID)
-	err = d.db.SaveState(d.group, d.resourceName, newID, config)
+	err = d.saveState(resource, newID, config)
 	if err != nil {
 		return fmt.Errorf("saving state for id=%s: %w", newID, err)
 	}
@@ -273,7 +334,7 @@ func (d *Deployer) Update(ctx context.Context, resource IResource, id string, co
 		return fmt.Errorf("updating id=%s: %w", id, err)
 	}
 
-	err = d.db.SaveState(d.group, d.resourceName, id, config)
+	err = d.saveState(resource, id, config)
 	if err != nil {
 		return fmt.Errorf("saving state id=%s: %w", id, err)
 	}
@@ -298,7 +359,7 @@ func (d *Deployer) UpdateWithID(ctx context.Context, resource IResource, updater
 		log.Infof(ctx, "Updated %s.%s id=%#v", d.group, d.resourceName, newID)
 	}
 
-	err = d.db.SaveState(d.group, d.resourceName, newID, config)
+	err = d.saveState(resource, newID, config)
 	if err != nil {
 		return fmt.Errorf("saving state id=%s: %w", oldID, err)
 	}
@@ -311,6 +372,25 @@ func (d *Deployer) UpdateWithID(ctx context.Context, resource IResource, updater
 	return nil
 }
 
+func (d *Deployer) Resize(ctx context.Context, resource IResource, resizer IResourceResize, id string, config any) error {
+	err := resizer.DoResize(ctx, id)
+	if err != nil {
+		return fmt.Errorf("resizing id=%s: %w", id, err)
+	}
+
+	err = d.saveState(resource, id, config)
+	if err != nil {
+		return fmt.Errorf("saving state id=%s: %w", id, err)
+	}
+
+	err = resource.WaitAfterUpdate(ctx)
+	if err != nil {
+		return fmt.Errorf("waiting after resizing id=%s: %w", id, err)
+	}
+
+	return nil
+}
+
 func (d *Deployer) Delete(ctx context.Context, oldID string) error {
 	// TODO: recognize 404 and 403 as "deleted" and proceed to removing state
 	err := DeleteResource(ctx, d.client, d.group, oldID)
@@ -326,6 +406,15 @@ func (d *Deployer) Delete(ctx context.Context, oldID string) error {
 	return nil
 }
 
+// saveState stores the config of the resource in the state together with its etag, if resource has one.
+func (d *Deployer) saveState(resource IResource, id string, config any) error {
+	etag := ""
+	if r, ok := resource.(IResourceETag); ok {
+		etag = r.GetETag()
+	}
+	return d.db.SaveState(d.group, d.resourceName, id, etag, config)
+}
+
 func typeConvert(destType reflect.Type, src any) (any, error) {
 	raw, err := json.Marshal(src)
 	if err != nil {
@@ -0,0 +1,98 @@
+package terranova
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/deployplan"
+	"github.com/databricks/databricks-sdk-go/apierr"
+	"golang.org/x/sync/errgroup"
+)
+
+// Binding associates a resource defined in the bundle with an existing resource in the workspace.
+type Binding struct {
+	deployplan.ResourceNode
+	ID string
+}
+
+// Bind records the existing resources in the deployment state, as if they had been deployed with their current remote settings.
+// It returns the actions that the next deployment would perform on the bound resources.
+//
+// The deployment state is only modified in memory; call Finalize on b.ResourceDatabase to save it.
+func Bind(ctx context.Context, b *bundle.Bundle, bindings []Binding) ([]deployplan.Action, error) {
+	if !b.DirectDeployment {
+		panic("direct deployment required")
+	}
+
+	err := b.OpenResourceDatabase(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	db := &b.ResourceDatabase
+	db.AssertOpened()
+
+	bound := make(map[deployplan.ResourceNode]bool, len(bindings))
+	for _, binding := range bindings {
+		settings, ok := SupportedResources[binding.Group]
+		if !ok || settings.ReadFN == nil {
+			return nil, fmt.Errorf("cannot bind %s: binding is not supported for %s", binding.ResourceNode, binding.Group)
+		}
+		if _, ok := b.GetResourceConfig(binding.Group, binding.Key); !ok {
+			return nil, fmt.Errorf("cannot bind %s: resource is not defined in the bundle", binding.ResourceNode)
+		}
+		if entry, ok := db.GetResourceEntry(binding.Group, binding.Key); ok {
+			return nil, fmt.Errorf("cannot bind %s: resource is already bound to id '%s'", binding.ResourceNode, entry.ID)
+		}
+		if bound[binding.ResourceNode] {
+			return nil, fmt.Errorf("cannot bind %s: resource is listed more than once", binding.ResourceNode)
+		}
+		bound[binding.ResourceNode] = true
+	}
+
+	client := b.WorkspaceClient()
+
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(defaultParallelism)
+
+	for _, binding := range bindings {
+		g.Go(func() error {
+			settings := SupportedResources[binding.Group]
+			remote, err := settings.ReadFN(gctx, client, binding.ID)
+			if errors.Is(err, apierr.ErrNotFound) {
+				return fmt.Errorf("cannot bind %s: resource with id '%s' is not found", binding.ResourceNode, binding.ID)
+			}
+			if err != nil {
+				return fmt.Errorf("cannot bind %s: reading resource with id '%s': %w", binding.ResourceNode, binding.ID, err)
+			}
+
+			state, err := typeConvert(settings.ConfigType, remote)
+			if err != nil {
+				return fmt.Errorf("cannot bind %s: interpreting remote settings: %w", binding.ResourceNode, err)
+			}
+
+			return db.SaveState(binding.Group, binding.Key, binding.ID, "", state)
+		})
+	}
+
+	err = g.Wait()
+	if err != nil {
+		return nil, err
+	}
+
+	err = CalculatePlanForDeploy(ctx, b)
+	if err != nil {
+		return nil, err
+	}
+
+	var actions []deployplan.Action
+	for _, action := range GetDeployActions(ctx, b) {
+		if bound[action.ResourceNode] {
+			actions = append(actions, action)
+		}
+	}
+
+	return actions, nil
+}
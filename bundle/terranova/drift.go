@@ -0,0 +1,146 @@
+package terranova
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/deployplan"
+	"github.com/databricks/cli/libs/diag"
+	"github.com/databricks/cli/libs/logdiag"
+	"github.com/databricks/cli/libs/structdiff"
+	"github.com/databricks/cli/libs/utils"
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/databricks-sdk-go/apierr"
+	"golang.org/x/sync/errgroup"
+)
+
+// CalculateDrift reads the remote settings of every resource in the deployment state and compares them with the
+// settings that were deployed. It returns the resources that were changed or deleted outside of the bundle.
+func CalculateDrift(ctx context.Context, b *bundle.Bundle) ([]deployplan.Drift, error) {
+	if !b.DirectDeployment {
+		panic("direct deployment required")
+	}
+
+	err := b.OpenResourceDatabase(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	db := &b.ResourceDatabase
+	db.AssertOpened()
+
+	client := b.WorkspaceClient()
+
+	var nodes []deployplan.ResourceNode
+	for _, group := range utils.SortedKeys(db.Data.Resources) {
+		settings, ok := SupportedResources[group]
+		if !ok || settings.ReadFN == nil {
+			logdiag.LogDiag(ctx, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("drift detection is not supported for %s, skipping %d resource(s)", group, len(db.Data.Resources[group])),
+			})
+			continue
+		}
+		for _, key := range utils.SortedKeys(db.Data.Resources[group]) {
+			nodes = append(nodes, deployplan.ResourceNode{Group: group, Key: key})
+		}
+	}
+
+	// Every goroutine writes to its own element, so no locking is needed.
+	results := make([]*deployplan.Drift, len(nodes))
+
+	g, ctx := errgroup.WithContext(ctx)
+	g.SetLimit(defaultParallelism)
+
+	for i, node := range nodes {
+		g.Go(func() error {
+			entry, _ := db.GetResourceEntry(node.Group, node.Key)
+			drift, err := calcDrift(ctx, SupportedResources[node.Group], client, entry.ID, entry.State)
+			if err != nil {
+				return fmt.Errorf("cannot read %s: %w", node, err)
+			}
+			if drift != nil {
+				drift.ResourceNode = node
+				results[i] = drift
+			}
+			return nil
+		})
+	}
+
+	err = g.Wait()
+	if err != nil {
+		return nil, err
+	}
+
+	drifts := make([]deployplan.Drift, 0, len(nodes))
+	for _, drift := range results {
+		if drift != nil {
+			drifts = append(drifts, *drift)
+		}
+	}
+
+	return drifts, nil
+}
+
+// calcDrift returns nil if the remote resource matches the saved state.
+func calcDrift(ctx context.Context, settings ResourceSettings, client *databricks.WorkspaceClient, id string, state any) (*deployplan.Drift, error) {
+	if id == "" {
+		return nil, errors.New("invalid state: empty id")
+	}
+
+	remote, err := settings.ReadFN(ctx, client, id)
+	if errors.Is(err, apierr.ErrNotFound) {
+		return &deployplan.Drift{ID: id, Deleted: true}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	changes, err := diffRemote(settings, state, remote)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(changes) == 0 {
+		return nil, nil
+	}
+
+	return &deployplan.Drift{ID: id, Changes: changes}, nil
+}
+
+// diffRemote compares the saved state with the remote settings of a resource.
+//
+// The remote settings include fields that are set by the server, such as defaults and computed values.
+// Fields that are unset in the saved state are therefore not reported, with the limitation that
+// a field removed from the config and then set outside of the bundle is not detected either.
+func diffRemote(settings ResourceSettings, state, remote any) ([]deployplan.FieldChange, error) {
+	savedState, err := typeConvert(settings.ConfigType, state)
+	if err != nil {
+		return nil, fmt.Errorf("interpreting state: %w", err)
+	}
+
+	remoteState, err := typeConvert(settings.ConfigType, remote)
+	if err != nil {
+		return nil, fmt.Errorf("interpreting remote settings: %w", err)
+	}
+
+	diff, err := structdiff.GetStructDiff(savedState, remoteState)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []deployplan.FieldChange
+	for _, change := range diff {
+		if _, isField := change.Path.Field(); isField && change.Old == nil {
+			continue
+		}
+		result = append(result, deployplan.FieldChange{
+			Path: change.Path.String(),
+			Old:  change.Old,
+			New:  change.New,
+		})
+	}
+	return result, nil
+}
@@ -0,0 +1,66 @@
+package terranova
+
+import (
+	"testing"
+
+	"github.com/databricks/cli/bundle/deployplan"
+	"github.com/databricks/databricks-sdk-go/service/catalog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffRemote(t *testing.T) {
+	saved := catalog.CreateSchema{
+		CatalogName: "main",
+		Name:        "myschema",
+		Comment:     "deployed comment",
+		Properties:  map[string]string{"a": "b"},
+	}
+
+	tests := []struct {
+		name     string
+		remote   catalog.SchemaInfo
+		expected []deployplan.FieldChange
+	}{
+		{
+			name: "no drift",
+			remote: catalog.SchemaInfo{
+				CatalogName: "main",
+				Name:        "myschema",
+				FullName:    "main.myschema",
+				Comment:     "deployed comment",
+				Properties:  map[string]string{"a": "b"},
+			},
+		},
+		{
+			name: "fields unset in the state are ignored",
+			remote: catalog.SchemaInfo{
+				CatalogName: "main",
+				Name:        "myschema",
+				Comment:     "deployed comment",
+				Properties:  map[string]string{"a": "b"},
+				StorageRoot: "s3://bucket/path",
+			},
+		},
+		{
+			name: "changed fields",
+			remote: catalog.SchemaInfo{
+				CatalogName: "main",
+				Name:        "myschema",
+				Properties:  map[string]string{"a": "b", "c": "d"},
+			},
+			expected: []deployplan.FieldChange{
+				{Path: ".comment", Old: "deployed comment", New: nil},
+				{Path: ".properties[\"c\"]", Old: nil, New: "d"},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			changes, err := diffRemote(SupportedResources["schemas"], saved, &tc.remote)
+			require.NoError(t, err)
+			assert.ElementsMatch(t, tc.expected, changes)
+		})
+	}
+}
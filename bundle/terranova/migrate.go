@@ -0,0 +1,245 @@
+package terranova
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/config"
+	"github.com/databricks/cli/bundle/deployplan"
+	"github.com/databricks/cli/bundle/statemgmt/resourcestate"
+	"github.com/databricks/cli/libs/diag"
+	"github.com/databricks/cli/libs/logdiag"
+	"github.com/databricks/cli/libs/utils"
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/databricks-sdk-go/apierr"
+	"golang.org/x/sync/errgroup"
+)
+
+// Migrate initializes the deployment state from the state of a Terraform deployment, so that the next
+// deployment with the direct engine updates the existing resources instead of creating them again.
+//
+// Every resource from the Terraform state is checked to still exist; resources that were deleted are left out
+// of the state and are created by the next deployment. The saved state of every resource is its remote settings,
+// so the next deployment applies any change made to the bundle since the last Terraform deployment.
+// Resource types that cannot be read from the workspace fall back to their config in the bundle.
+// The state keeps the lineage of the Terraform state; its serial is increased.
+func Migrate(ctx context.Context, b *bundle.Bundle, state resourcestate.ExportedResourcesMap, lineage string, serial int) error {
+	if !b.DirectDeployment {
+		panic("direct deployment required")
+	}
+
+	err := b.OpenResourceDatabase(ctx)
+	if err != nil {
+		return err
+	}
+
+	db := &b.ResourceDatabase
+	db.AssertOpened()
+
+	if len(db.Data.Resources) > 0 {
+		return fmt.Errorf("deployment state of the direct deployment engine already exists (serial=%d); the bundle has already been migrated", db.Data.Serial)
+	}
+
+	for _, group := range utils.SortedKeys(state) {
+		if _, ok := SupportedResources[group]; !ok {
+			return fmt.Errorf("cannot migrate %s: resource type not supported on direct backend", group)
+		}
+	}
+
+	client := b.WorkspaceClient()
+
+	existing, remotes, err := checkExisting(ctx, client, &b.Config, state)
+	if err != nil {
+		return err
+	}
+
+	b.Graph, err = makeResourceGraph(ctx, b)
+	if err != nil {
+		return fmt.Errorf("reading config: %w", err)
+	}
+
+	err = b.Graph.DetectCycle()
+	if err != nil {
+		return err
+	}
+
+	// Resources are processed in DAG order so that references to their IDs and fields are resolved
+	// in the config of the resources that depend on them, same as CalculatePlanForDeploy does.
+	b.Graph.Run(1, func(node deployplan.ResourceNode, failedDependency *deployplan.ResourceNode) bool {
+		errorPrefix := fmt.Sprintf("cannot migrate %s.%s", node.Group, node.Key)
+
+		if failedDependency != nil {
+			logdiag.LogError(ctx, fmt.Errorf("%s: dependency failed: %s", errorPrefix, failedDependency.String()))
+			return false
+		}
+
+		entry, ok := existing[node.Group][node.Key]
+		if !ok {
+			// Not deployed yet, the next deployment creates it.
+			return true
+		}
+
+		inputConfig, ok := b.GetResourceConfig(node.Group, node.Key)
+		if !ok {
+			logdiag.LogError(ctx, fmt.Errorf("%s: internal error: cannot read config", errorPrefix))
+			return false
+		}
+
+		savedState, err := migratedState(ctx, client, node, inputConfig, remotes[node])
+		if err != nil {
+			logdiag.LogError(ctx, fmt.Errorf("%s: %w", errorPrefix, err))
+			return false
+		}
+
+		err = db.SaveState(node.Group, node.Key, entry.ID, entry.ETag, savedState)
+		if err != nil {
+			logdiag.LogError(ctx, fmt.Errorf("%s: %w", errorPrefix, err))
+			return false
+		}
+
+		err = resolveReferences(ctx, b, node, inputConfig, true)
+		if err != nil {
+			logdiag.LogError(ctx, fmt.Errorf("%s: %w", errorPrefix, err))
+			return false
+		}
+
+		return true
+	})
+
+	if logdiag.HasError(ctx) {
+		return errors.New("migration failed")
+	}
+
+	// Resources that were removed from the config are kept, so that the next deployment deletes them.
+	for _, group := range utils.SortedKeys(existing) {
+		for _, key := range utils.SortedKeys(existing[group]) {
+			node := deployplan.ResourceNode{Group: group, Key: key}
+			if b.Graph.HasNode(node) {
+				continue
+			}
+			entry := existing[group][key]
+			err = db.SaveState(group, key, entry.ID, entry.ETag, nil)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	db.Data.Lineage = lineage
+	db.Data.Serial = serial
+	return db.Finalize()
+}
+
+// migratedState returns the state to save for a migrated resource: its remote settings if they were read,
+// and otherwise the config of the resource in the bundle.
+func migratedState(ctx context.Context, client *databricks.WorkspaceClient, node deployplan.ResourceNode, inputConfig, remote any) (any, error) {
+	if remote != nil {
+		return typeConvert(SupportedResources[node.Group].ConfigType, remote)
+	}
+
+	logdiag.LogDiag(ctx, diag.Diagnostic{
+		Severity: diag.Warning,
+		Summary:  fmt.Sprintf("%s cannot be read from the workspace; changes to its config since the last deployment are not applied by the next deployment", node),
+	})
+
+	resource, _, err := New(client, node.Group, node.Key, inputConfig)
+	if err != nil {
+		return nil, err
+	}
+	return resource.Config(), nil
+}
+
+// checkExisting returns the resources from state that still exist in the workspace,
+// along with the remote settings of those of them that were read with ReadFN.
+func checkExisting(ctx context.Context, client *databricks.WorkspaceClient, root *config.Root, state resourcestate.ExportedResourcesMap) (resourcestate.ExportedResourcesMap, map[deployplan.ResourceNode]any, error) {
+	var nodes []deployplan.ResourceNode
+	for _, group := range utils.SortedKeys(state) {
+		for _, key := range utils.SortedKeys(state[group]) {
+			nodes = append(nodes, deployplan.ResourceNode{Group: group, Key: key})
+		}
+	}
+
+	// Every goroutine writes to its own elements, so no locking is needed.
+	results := make([]bool, len(nodes))
+	remoteResults := make([]any, len(nodes))
+
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(defaultParallelism)
+
+	for i, node := range nodes {
+		g.Go(func() error {
+			remote, exists, err := resourceExists(gctx, client, root, node, state[node.Group][node.Key].ID)
+			if err != nil {
+				return fmt.Errorf("cannot migrate %s: %w", node, err)
+			}
+			results[i] = exists
+			remoteResults[i] = remote
+			return nil
+		})
+	}
+
+	err := g.Wait()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	existing := make(resourcestate.ExportedResourcesMap)
+	remotes := make(map[deployplan.ResourceNode]any)
+	for i, node := range nodes {
+		entry := state[node.Group][node.Key]
+		if !results[i] {
+			logdiag.LogDiag(ctx, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("%s with id '%s' no longer exists and will be created by the next deployment", node, entry.ID),
+			})
+			continue
+		}
+		if existing[node.Group] == nil {
+			existing[node.Group] = make(map[string]resourcestate.ResourceState)
+		}
+		existing[node.Group][node.Key] = entry
+		if remoteResults[i] != nil {
+			remotes[node] = remoteResults[i]
+		}
+	}
+
+	return existing, remotes, nil
+}
+
+// resourceExists checks whether the resource with the given ID exists in the workspace. It uses ReadFN if the resource type has one,
+// in which case the remote settings are returned, and otherwise falls back to the Exists method of the resource in the bundle configuration.
+func resourceExists(ctx context.Context, client *databricks.WorkspaceClient, root *config.Root, node deployplan.ResourceNode, id string) (any, bool, error) {
+	if id == "" {
+		return nil, false, errors.New("invalid state: empty id")
+	}
+
+	var remote any
+	var err error
+	if settings := SupportedResources[node.Group]; settings.ReadFN != nil {
+		remote, err = settings.ReadFN(ctx, client, id)
+	} else if resource := findConfigResource(root, node); resource != nil {
+		_, err = resource.Exists(ctx, client, id)
+	} else {
+		// Neither can be used to check the resource; assume it exists, the next deployment deletes it.
+		return nil, true, nil
+	}
+
+	if errors.Is(err, apierr.ErrNotFound) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	return remote, true, nil
+}
+
+func findConfigResource(root *config.Root, node deployplan.ResourceNode) config.ConfigResource {
+	for _, group := range root.Resources.AllResources() {
+		if group.Description.PluralName == node.Group {
+			return group.Resources[node.Key]
+		}
+	}
+	return nil
+}
@@ -28,43 +28,44 @@ type Planner struct {
 	settings     ResourceSettings
 }
 
-func (d *Planner) Plan(ctx context.Context, inputConfig any) (deployplan.ActionType, error) {
-	result, err := d.plan(ctx, inputConfig)
+func (d *Planner) Plan(ctx context.Context, inputConfig any) (deployplan.ActionType, []deployplan.FieldChange, error) {
+	result, changes, err := d.plan(ctx, inputConfig)
 	if err != nil {
-		return deployplan.ActionTypeNoop, fmt.Errorf("planning: %s.%s: %w", d.group, d.resourceName, err)
+		return deployplan.ActionTypeNoop, nil, fmt.Errorf("planning: %s.%s: %w", d.group, d.resourceName, err)
 	}
-	return result, err
+	return result, changes, err
 }
 
-func (d *Planner) plan(_ context.Context, inputConfig any) (deployplan.ActionType, error) {
+func (d *Planner) plan(_ context.Context, inputConfig any) (deployplan.ActionType, []deployplan.FieldChange, error) {
 	entry, hasEntry := d.db.GetResourceEntry(d.group, d.resourceName)
 
 	resource, cfgType, err := New(d.client, d.group, d.resourceName, inputConfig)
 	if err != nil {
-		return "", err
+		return "", nil, err
 	}
 
 	config := resource.Config()
 
 	if !hasEntry {
-		return deployplan.ActionTypeCreate, nil
+		return deployplan.ActionTypeCreate, nil, nil
 	}
 
 	oldID := entry.ID
 	if oldID == "" {
-		return "", errors.New("invalid state: empty id")
+		return "", nil, errors.New("invalid state: empty id")
 	}
 
 	savedState, err := typeConvert(cfgType, entry.State)
 	if err != nil {
-		return "", fmt.Errorf("interpreting state: %w", err)
+		return "", nil, fmt.Errorf("interpreting state: %w", err)
 	}
 
 	// Note, currently we're diffing static structs, not dynamic value.
 	// This means for fields that contain references like ${resources.group.foo.id} we do one of the following:
 	// for strings: comparing unresolved string like "${resoures.group.foo.id}" with actual object id. As long as IDs do not have ${...} format we're good.
 	// for integers: compare 0 with actual object ID. As long as real object IDs are never 0 we're good.
-	// Once we add non-id fields or add per-field details to "bundle plan", we must read dynamic data and deal with references as first class citizen.
+	// The per-field details shown by "bundle plan" have the same limitation: an unresolved reference shows up as its "${...}" string or as 0.
+	// Once we add non-id fields, we must read dynamic data and deal with references as first class citizen.
 	// This means distinguishing between 0 that are actually object ids and 0 that are there because typed struct integer cannot contain ${...} string.
 	return calcDiff(d.settings, resource, savedState, config)
 }
@@ -75,6 +76,7 @@ func GetDeployActions(ctx context.Context, b *bundle.Bundle) []deployplan.Action
 		actions = append(actions, deployplan.Action{
 			ResourceNode: node,
 			ActionType:   actionType,
+			Changes:      b.PlannedChanges[node],
 		})
 	}
 
@@ -112,7 +114,36 @@ func CalculatePlanForDeploy(ctx context.Context, b *bundle.Bundle) error {
 		return err
 	}
 
+	state := b.ResourceDatabase.ExportState(ctx)
+
+	// With "bundle deploy --only", only the selected resources and the resources they reference are planned.
+	var selector *resourceSelector
+	fullGraph := b.Graph
+	if len(b.OnlyResources) > 0 {
+		selector, err = newResourceSelector(b.OnlyResources)
+		if err != nil {
+			return err
+		}
+
+		nodes := b.Graph.Nodes()
+		for _, group := range utils.SortedKeys(state) {
+			for _, key := range utils.SortedKeys(state[group]) {
+				if n := (deployplan.ResourceNode{Group: group, Key: key}); !b.Graph.HasNode(n) {
+					nodes = append(nodes, n)
+				}
+			}
+		}
+
+		selected, err := selector.selectNodes(nodes)
+		if err != nil {
+			return err
+		}
+
+		b.Graph = b.Graph.Subgraph(selected)
+	}
+
 	b.PlannedActions = make(map[deployplan.ResourceNode]deployplan.ActionType)
+	b.PlannedChanges = make(map[deployplan.ResourceNode][]deployplan.FieldChange)
 
 	// We're processing resources in DAG order, because we're trying to get rid of all references like $resources.jobs.foo.id
 	// if jobs.foo is not going to be (re)created. This means by the time we get to resource depending on $resources.jobs.foo.id
@@ -147,50 +178,23 @@ func CalculatePlanForDeploy(ctx context.Context, b *bundle.Bundle) error {
 		}
 
 		// This currently does not do API calls, so we can run this sequentially. Once we have remote diffs, we need to run a in threadpool.
-		actionType, err := pl.Plan(ctx, config)
+		actionType, changes, err := pl.Plan(ctx, config)
 		if err != nil {
 			logdiag.LogError(ctx, err)
 			return false
 		}
 
-		for _, reference := range b.Graph.OutgoingLabels(node) {
-			path, ok := dynvar.PureReferenceToPath(reference)
-			if !ok || len(path) <= 3 || path[0].Key() != "resources" || path[1].Key() != node.Group || path[2].Key() != node.Key {
-				logdiag.LogError(ctx, fmt.Errorf("internal error: expected reference to resources.%s.%s, got %q", node.Group, node.Key, reference))
-				return false
-			}
-			fieldPath := path[3:].String()
-			if fieldPath == "id" {
-				if actionType.KeepsID() {
-					// Now that we know that ID of this node is not going to change, update it
-					// everywhere to actual value to calculate more accurate and more conservative action for dependent nodes.
-					err = resolveIDReference(ctx, b, pl.group, pl.resourceName)
-					if err != nil {
-						logdiag.LogError(ctx, fmt.Errorf("failed to replace ref to resources.%s.%s.id: %w", pl.group, pl.resourceName, err))
-						return false
-					}
-				}
-				continue
-			}
-			dynPath, err := dyn.NewPathFromString(fieldPath)
-			if err != nil {
-				logdiag.LogError(ctx, fmt.Errorf("cannot parse path %s: %w", fieldPath, err))
-				return false
-			}
-			value, err := structaccess.Get(config, dynPath)
-			if err != nil {
-				logdiag.LogError(ctx, fmt.Errorf("cannot resolve %s: %w", reference, err))
-				return false
-			}
-			err = resolveFieldReference(ctx, b, path, value)
-			if err != nil {
-				logdiag.LogError(ctx, fmt.Errorf("failed to replace ref to %s: %w", reference, err))
-				return false
-			}
+		err = resolveReferences(ctx, b, node, config, actionType.KeepsID())
+		if err != nil {
+			logdiag.LogError(ctx, err)
+			return false
 		}
 
 		if actionType != deployplan.ActionTypeNoop {
 			b.PlannedActions[node] = actionType
+			if len(changes) > 0 {
+				b.PlannedChanges[node] = changes
+			}
 		}
 		return true
 	})
@@ -199,7 +203,12 @@ func CalculatePlanForDeploy(ctx context.Context, b *bundle.Bundle) error {
 		return errors.New("planning failed")
 	}
 
-	state := b.ResourceDatabase.ExportState(ctx)
+	if selector != nil {
+		err = checkReferencesToNewIDs(fullGraph, b.Graph, b.PlannedActions, state)
+		if err != nil {
+			return err
+		}
+	}
 
 	// Remained in state are resources that no longer present in the config
 	for _, group := range utils.SortedKeys(state) {
@@ -210,6 +219,10 @@ func CalculatePlanForDeploy(ctx context.Context, b *bundle.Bundle) error {
 				// action was already added by Run() above
 				continue
 			}
+			if selector != nil && !selector.matches(n) {
+				// not selected for deployment, keep it in the state
+				continue
+			}
 			b.PlannedActions[n] = deployplan.ActionTypeDelete
 		}
 	}
@@ -244,18 +257,20 @@ func CalculatePlanForDestroy(ctx context.Context, b *bundle.Bundle) error {
 	return nil
 }
 
-func calcDiff(settings ResourceSettings, resource IResource, savedState, config any) (deployplan.ActionType, error) {
+func calcDiff(settings ResourceSettings, resource IResource, savedState, config any) (deployplan.ActionType, []deployplan.FieldChange, error) {
 	localDiff, err := structdiff.GetStructDiff(savedState, config)
 	if err != nil {
-		return "", err
+		return "", nil, err
 	}
 
 	if len(localDiff) == 0 {
-		return deployplan.ActionTypeNoop, nil
+		return deployplan.ActionTypeNoop, nil, nil
 	}
 
+	changes := settings.FieldChanges(localDiff)
+
 	if settings.MustRecreate(localDiff) {
-		return deployplan.ActionTypeRecreate, nil
+		return deployplan.ActionTypeRecreate, changes, nil
 	}
 
 	customClassify, hasCustomClassify := resource.(IResourceCustomClassify)
@@ -265,15 +280,55 @@ func calcDiff(settings ResourceSettings, resource IResource, savedState, config
 
 		result := customClassify.ClassifyChanges(localDiff)
 		if result == deployplan.ActionTypeRecreate && !settings.RecreateAllowed {
-			return "", errors.New("internal error: unexpected plan='recreate'")
+			return "", nil, errors.New("internal error: unexpected plan='recreate'")
 		}
 
 		if result == deployplan.ActionTypeUpdateWithID && !hasUpdateWithID {
-			return "", errors.New("internal error: unexpected plan='update_with_id'")
+			return "", nil, errors.New("internal error: unexpected plan='update_with_id'")
+		}
+
+		if _, hasResize := resource.(IResourceResize); result == deployplan.ActionTypeResize && !hasResize {
+			return "", nil, errors.New("internal error: unexpected plan='resize'")
 		}
 
-		return result, nil
+		return result, changes, nil
 	}
 
-	return deployplan.ActionTypeUpdate, nil
+	return deployplan.ActionTypeUpdate, changes, nil
+}
+
+// resolveReferences replaces references to the fields of node in the config of the resources that depend on it.
+// References to the ID are only replaced if keepsID is set, because otherwise the ID is not known until the resource is deployed.
+func resolveReferences(ctx context.Context, b *bundle.Bundle, node deployplan.ResourceNode, config any, keepsID bool) error {
+	for _, reference := range b.Graph.OutgoingLabels(node) {
+		path, ok := dynvar.PureReferenceToPath(reference)
+		if !ok || len(path) <= 3 || path[0].Key() != "resources" || path[1].Key() != node.Group || path[2].Key() != node.Key {
+			return fmt.Errorf("internal error: expected reference to resources.%s.%s, got %q", node.Group, node.Key, reference)
+		}
+		fieldPath := path[3:].String()
+		if fieldPath == "id" {
+			if keepsID {
+				// Now that we know that ID of this node is not going to change, update it
+				// everywhere to actual value to calculate more accurate and more conservative action for dependent nodes.
+				err := resolveIDReference(ctx, b, node.Group, node.Key)
+				if err != nil {
+					return fmt.Errorf("failed to replace ref to resources.%s.%s.id: %w", node.Group, node.Key, err)
+				}
+			}
+			continue
+		}
+		dynPath, err := dyn.NewPathFromString(fieldPath)
+		if err != nil {
+			return fmt.Errorf("cannot parse path %s: %w", fieldPath, err)
+		}
+		value, err := structaccess.Get(config, dynPath)
+		if err != nil {
+			return fmt.Errorf("cannot resolve %s: %w", reference, err)
+		}
+		err = resolveFieldReference(ctx, b, path, value)
+		if err != nil {
+			return fmt.Errorf("failed to replace ref to %s: %w", reference, err)
+		}
+	}
+	return nil
 }
@@ -0,0 +1,152 @@
+package terranova
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/deployplan"
+	"github.com/databricks/cli/libs/dyn"
+	"github.com/databricks/cli/libs/dyn/convert"
+	"github.com/databricks/cli/libs/dyn/jsonloader"
+	"github.com/databricks/cli/libs/logdiag"
+	"github.com/databricks/cli/libs/utils"
+)
+
+// GetPlan returns the plan calculated by CalculatePlanForDeploy together with the resolved configuration
+// of the resources it creates or updates and the state it was calculated against.
+func GetPlan(ctx context.Context, b *bundle.Bundle) (*deployplan.Plan, error) {
+	db := &b.ResourceDatabase
+	db.AssertOpened()
+
+	plan := &deployplan.Plan{
+		Version: deployplan.PlanVersion,
+		Lineage: db.Data.Lineage,
+		Serial:  db.Data.Serial,
+		Actions: GetDeployActions(ctx, b),
+		Config:  make(map[string]map[string]json.RawMessage),
+	}
+
+	for _, action := range plan.Actions {
+		if action.ActionType == deployplan.ActionTypeDelete {
+			continue
+		}
+
+		v, err := dyn.GetByPath(b.Config.Value(), resourcePath(action.Group, action.Key))
+		if err != nil {
+			return nil, fmt.Errorf("reading config for %s: %w", action.ResourceNode, err)
+		}
+
+		data, err := json.Marshal(v.AsAny())
+		if err != nil {
+			return nil, fmt.Errorf("serializing config for %s: %w", action.ResourceNode, err)
+		}
+
+		group, ok := plan.Config[action.Group]
+		if !ok {
+			group = make(map[string]json.RawMessage)
+			plan.Config[action.Group] = group
+		}
+		group[action.Key] = data
+	}
+
+	return plan, nil
+}
+
+// LoadPlan makes a saved plan the planned deployment of the bundle, in place of CalculatePlanForDeploy.
+// The configuration of every resource in the plan is replaced with the configuration saved in the plan.
+// It fails if the deployment state has changed since the plan was calculated.
+func LoadPlan(ctx context.Context, b *bundle.Bundle, plan *deployplan.Plan) error {
+	if !b.DirectDeployment {
+		panic("direct deployment required")
+	}
+
+	err := b.OpenResourceDatabase(ctx)
+	if err != nil {
+		return err
+	}
+
+	db := &b.ResourceDatabase
+	db.AssertOpened()
+
+	if plan.Lineage != db.Data.Lineage || plan.Serial != db.Data.Serial {
+		return fmt.Errorf("deployment state has changed since the plan was created (plan: serial=%d lineage=%q, current: serial=%d lineage=%q); create a new plan with \"bundle plan --out\"",
+			plan.Serial, plan.Lineage, db.Data.Serial, db.Data.Lineage)
+	}
+
+	for _, action := range plan.Actions {
+		if _, ok := SupportedResources[action.Group]; !ok {
+			return fmt.Errorf("cannot %s %s: resource type not supported on direct backend", action.ActionType, action.ResourceNode)
+		}
+		if action.ActionType == deployplan.ActionTypeDelete {
+			continue
+		}
+		if _, ok := plan.Config[action.Group][action.Key]; !ok {
+			return fmt.Errorf("cannot %s %s: plan does not contain its config", action.ActionType, action.ResourceNode)
+		}
+	}
+
+	err = b.Config.Mutate(func(root dyn.Value) (dyn.Value, error) {
+		for _, group := range utils.SortedKeys(plan.Config) {
+			for _, key := range utils.SortedKeys(plan.Config[group]) {
+				value, err := jsonloader.LoadJSON(plan.Config[group][key], "plan")
+				if err != nil {
+					return root, fmt.Errorf("reading config for %s.%s from plan: %w", group, key, err)
+				}
+
+				// Keep the location of the resource, it is used to compute deployment metadata.
+				existing, err := dyn.GetByPath(root, resourcePath(group, key))
+				if err != nil {
+					return root, fmt.Errorf("resource %s.%s from the plan is no longer defined in the configuration; create a new plan with \"bundle plan --out\"", group, key)
+				}
+
+				root, err = dyn.SetByPath(root, resourcePath(group, key), value.WithLocations(existing.Locations()))
+				if err != nil {
+					return root, err
+				}
+			}
+		}
+
+		// Numbers are loaded from JSON as floats; normalize them back to the types used in the config.
+		root, diags := convert.Normalize(b.Config, root)
+		for _, d := range diags {
+			logdiag.LogDiag(ctx, d)
+		}
+		return root, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if logdiag.HasError(ctx) {
+		return errors.New("failed to load config from plan")
+	}
+
+	b.Graph, err = makeResourceGraph(ctx, b)
+	if err != nil {
+		return fmt.Errorf("reading config: %w", err)
+	}
+
+	err = b.Graph.DetectCycle()
+	if err != nil {
+		return err
+	}
+
+	b.PlannedActions = make(map[deployplan.ResourceNode]deployplan.ActionType)
+	b.PlannedChanges = make(map[deployplan.ResourceNode][]deployplan.FieldChange)
+
+	for _, action := range plan.Actions {
+		b.PlannedActions[action.ResourceNode] = action.ActionType
+		if len(action.Changes) > 0 {
+			b.PlannedChanges[action.ResourceNode] = action.Changes
+		}
+	}
+
+	return nil
+}
+
+func resourcePath(group, key string) dyn.Path {
+	return dyn.NewPath(dyn.Key("resources"), dyn.Key(group), dyn.Key(key))
+}
@@ -0,0 +1,101 @@
+package terranova
+
+import (
+	"testing"
+
+	"github.com/databricks/cli/bundle/config/resources"
+	"github.com/databricks/cli/bundle/deployplan"
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/databricks-sdk-go/service/catalog"
+	"github.com/databricks/databricks-sdk-go/service/serving"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCalcDiffReturnsFieldChanges(t *testing.T) {
+	client := &databricks.WorkspaceClient{}
+
+	saved := catalog.CreateSchema{
+		CatalogName: "main",
+		Name:        "myschema",
+		Comment:     "old comment",
+	}
+
+	tests := []struct {
+		name     string
+		config   catalog.CreateSchema
+		action   deployplan.ActionType
+		expected []deployplan.FieldChange
+	}{
+		{
+			name:   "noop",
+			config: saved,
+			action: deployplan.ActionTypeNoop,
+		},
+		{
+			name: "update",
+			config: catalog.CreateSchema{
+				CatalogName: "main",
+				Name:        "myschema",
+				Comment:     "new comment",
+			},
+			action: deployplan.ActionTypeUpdate,
+			expected: []deployplan.FieldChange{
+				{Path: ".comment", Old: "old comment", New: "new comment"},
+			},
+		},
+		{
+			name: "recreate",
+			config: catalog.CreateSchema{
+				CatalogName: "other",
+				Name:        "myschema",
+				Comment:     "new comment",
+			},
+			action: deployplan.ActionTypeRecreate,
+			expected: []deployplan.FieldChange{
+				{Path: ".catalog_name", Old: "main", New: "other", Recreate: true},
+				{Path: ".comment", Old: "old comment", New: "new comment"},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			resource, _, err := New(client, "schemas", "foo", &resources.Schema{CreateSchema: tc.config})
+			require.NoError(t, err)
+
+			action, changes, err := calcDiff(SupportedResources["schemas"], resource, saved, resource.Config())
+			require.NoError(t, err)
+			assert.Equal(t, tc.action, action)
+			assert.ElementsMatch(t, tc.expected, changes)
+		})
+	}
+}
+
+func TestCalcDiffRecreatesOnChangeOfSubField(t *testing.T) {
+	client := &databricks.WorkspaceClient{}
+
+	saved := serving.CreateServingEndpoint{
+		Name: "endpoint",
+		EmailNotifications: &serving.EmailNotifications{
+			OnUpdateFailure: []string{"old@example.com"},
+		},
+	}
+	config := serving.CreateServingEndpoint{
+		Name: "endpoint",
+		EmailNotifications: &serving.EmailNotifications{
+			OnUpdateFailure: []string{"new@example.com"},
+		},
+	}
+
+	resource, _, err := New(client, "model_serving_endpoints", "foo", &resources.ModelServingEndpoint{CreateServingEndpoint: config})
+	require.NoError(t, err)
+
+	action, changes, err := calcDiff(SupportedResources["model_serving_endpoints"], resource, saved, resource.Config())
+	require.NoError(t, err)
+	assert.Equal(t, deployplan.ActionTypeRecreate, action)
+	require.NotEmpty(t, changes)
+	for _, change := range changes {
+		assert.True(t, change.Recreate, change.Path)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"strings"
 
 	"github.com/databricks/cli/bundle/deployplan"
 	"github.com/databricks/cli/bundle/terranova/tnresources"
@@ -14,6 +15,8 @@ import (
 
 type DeleteResourceFN = func(ctx context.Context, client *databricks.WorkspaceClient, oldID string) error
 
+type ReadResourceFN = func(ctx context.Context, client *databricks.WorkspaceClient, id string) (any, error)
+
 type ResourceSettings struct {
 	// Method to call to create new resource
 	// First argument must be client* databricks.Workspace and second argument is *resource.<Resource> from bundle config
@@ -26,11 +29,15 @@ type ResourceSettings struct {
 	// Function to delete a resource of this type
 	DeleteFN DeleteResourceFN
 
+	// Function to read the remote settings of a resource of this type. The result must be convertible to ConfigType.
+	// Optional, resources without it are not supported by drift detection.
+	ReadFN ReadResourceFN
+
 	// true if ClassifyChanges() method can return a different ActionTypeRecreate
 	// If RecreateAllowed is false and RecreateFields is empty, the resource id is stable.
 	RecreateAllowed bool
 
-	// If any of these fields are changed, recreation (Delete + Create) is triggered.
+	// If any of these fields or their sub-fields are changed, recreation (Delete + Create) is triggered.
 	// This overrides ClassifyChanges() function (so you don't need to implement that one).
 	// Fields are in structdiff.Change.String() format.
 	// Limitation: patterns like hello.*.world and hello[*].world are not supported
@@ -42,13 +49,43 @@ func (s *ResourceSettings) MustRecreate(changes []structdiff.Change) bool {
 		return false
 	}
 	for _, change := range changes {
-		if _, ok := s.RecreateFields[change.Path.String()]; ok {
+		if s.isRecreateField(change.Path.String()) {
 			return true
 		}
 	}
 	return false
 }
 
+// isRecreateField returns true if the path is one of RecreateFields or is located under one.
+func (s *ResourceSettings) isRecreateField(path string) bool {
+	for {
+		if _, ok := s.RecreateFields[path]; ok {
+			return true
+		}
+		i := strings.LastIndexAny(path, ".[")
+		if i <= 0 {
+			return false
+		}
+		path = path[:i]
+	}
+}
+
+// FieldChanges converts a structdiff result into plan field changes, marking the fields that force recreation.
+func (s *ResourceSettings) FieldChanges(changes []structdiff.Change) []deployplan.FieldChange {
+	result := make([]deployplan.FieldChange, 0, len(changes))
+	for _, change := range changes {
+		path := change.Path.String()
+		recreate := s.isRecreateField(path)
+		result = append(result, deployplan.FieldChange{
+			Path:     path,
+			Old:      change.Old,
+			New:      change.New,
+			Recreate: recreate,
+		})
+	}
+	return result
+}
+
 // TypeOfConfig returns the reflect.Type of the configuration returned by the resource's Config() method.
 func TypeOfConfig(resource IResource) reflect.Type {
 	return reflect.TypeOf(resource.Config())
@@ -59,11 +96,13 @@ var SupportedResources = map[string]ResourceSettings{
 		New:        reflect.ValueOf(tnresources.NewResourceJob),
 		ConfigType: TypeOfConfig(&tnresources.ResourceJob{}),
 		DeleteFN:   tnresources.DeleteJob,
+		ReadFN:     tnresources.ReadJob,
 	},
 	"pipelines": {
 		New:        reflect.ValueOf(tnresources.NewResourcePipeline),
 		ConfigType: TypeOfConfig(&tnresources.ResourcePipeline{}),
 		DeleteFN:   tnresources.DeletePipeline,
+		ReadFN:     tnresources.ReadPipeline,
 		// See TF's ForceNew fields:
 		// https://github.com/databricks/terraform-provider-databricks/blob/8ae24ac/pipelines/resource_pipeline.go#L207
 		RecreateFields: mkMap(
@@ -77,6 +116,7 @@ var SupportedResources = map[string]ResourceSettings{
 		New:        reflect.ValueOf(tnresources.NewResourceSchema),
 		ConfigType: TypeOfConfig(&tnresources.ResourceSchema{}),
 		DeleteFN:   tnresources.DeleteSchema,
+		ReadFN:     tnresources.ReadSchema,
 		// TF: https://github.com/databricks/terraform-provider-databricks/blob/03a2515/catalog/resource_schema.go#L14
 		RecreateFields: mkMap(
 			".name",
@@ -88,6 +128,7 @@ var SupportedResources = map[string]ResourceSettings{
 		New:        reflect.ValueOf(tnresources.NewResourceVolume),
 		ConfigType: TypeOfConfig(&tnresources.ResourceVolume{}),
 		DeleteFN:   tnresources.DeleteVolume,
+		ReadFN:     tnresources.ReadVolume,
 		// TF: https://github.com/databricks/terraform-provider-databricks/blob/f5fce0f/catalog/resource_volume.go#L19
 		RecreateFields: mkMap(
 			".catalog_name",
@@ -100,6 +141,7 @@ var SupportedResources = map[string]ResourceSettings{
 		New:        reflect.ValueOf(tnresources.NewResourceApp),
 		ConfigType: TypeOfConfig(&tnresources.ResourceApp{}),
 		DeleteFN:   tnresources.DeleteApp,
+		ReadFN:     tnresources.ReadApp,
 		RecreateFields: mkMap(
 			".name",
 		),
@@ -114,6 +156,59 @@ var SupportedResources = map[string]ResourceSettings{
 		ConfigType: TypeOfConfig(&tnresources.ResourceDatabaseInstance{}),
 		DeleteFN:   tnresources.DeleteDatabaseInstance,
 	},
+	"clusters": {
+		New:        reflect.ValueOf(tnresources.NewResourceCluster),
+		ConfigType: TypeOfConfig(&tnresources.ResourceCluster{}),
+		DeleteFN:   tnresources.DeleteCluster,
+		ReadFN:     tnresources.ReadCluster,
+	},
+	"dashboards": {
+		New:        reflect.ValueOf(tnresources.NewResourceDashboard),
+		ConfigType: TypeOfConfig(&tnresources.ResourceDashboard{}),
+		DeleteFN:   tnresources.DeleteDashboard,
+		// TF: https://github.com/databricks/terraform-provider-databricks/blob/b2ffa4d/dashboards/resource_dashboard.go#L60
+		RecreateFields: mkMap(
+			".parent_path",
+		),
+	},
+	"experiments": {
+		New:        reflect.ValueOf(tnresources.NewResourceExperiment),
+		ConfigType: TypeOfConfig(&tnresources.ResourceExperiment{}),
+		DeleteFN:   tnresources.DeleteExperiment,
+		ReadFN:     tnresources.ReadExperiment,
+		RecreateFields: mkMap(
+			".artifact_location",
+		),
+	},
+	"models": {
+		New:        reflect.ValueOf(tnresources.NewResourceModel),
+		ConfigType: TypeOfConfig(&tnresources.ResourceModel{}),
+		DeleteFN:   tnresources.DeleteModel,
+	},
+	"registered_models": {
+		New:        reflect.ValueOf(tnresources.NewResourceRegisteredModel),
+		ConfigType: TypeOfConfig(&tnresources.ResourceRegisteredModel{}),
+		DeleteFN:   tnresources.DeleteRegisteredModel,
+		ReadFN:     tnresources.ReadRegisteredModel,
+		RecreateFields: mkMap(
+			".catalog_name",
+			".schema_name",
+			".storage_location",
+		),
+	},
+	"model_serving_endpoints": {
+		New:        reflect.ValueOf(tnresources.NewResourceModelServingEndpoint),
+		ConfigType: TypeOfConfig(&tnresources.ResourceModelServingEndpoint{}),
+		DeleteFN:   tnresources.DeleteModelServingEndpoint,
+		// These fields cannot be changed through any of the update APIs.
+		RecreateFields: mkMap(
+			".name",
+			".budget_policy_id",
+			".description",
+			".email_notifications",
+			".route_optimized",
+		),
+	},
 }
 
 type IResource interface {
@@ -142,6 +237,25 @@ type IResourceUpdatesID interface {
 	DoUpdateWithID(ctx context.Context, oldID string) (string, error)
 }
 
+// Optional method for resources that support resizing without a full update.
+type IResourceResize interface {
+	// Resize the resource. This will only be called if actiontype is ActionTypeResize, so ClassifyChanges must be implemented as well.
+	DoResize(ctx context.Context, id string) error
+}
+
+// Optional method for resources that need the state saved by the last deployment to update the resource,
+// e.g. to clear settings that cannot be read back from the workspace.
+type IResourceSavedState interface {
+	// SetSavedState is called before DoUpdate with the saved state, converted to the config type.
+	SetSavedState(state any)
+}
+
+// Optional method for resources that have an etag that must be stored in the state.
+type IResourceETag interface {
+	// Returns etag of the resource after the last DoCreate() or DoUpdate() call.
+	GetETag() string
+}
+
 // invokeConstructor converts cfg to the parameter type expected by ctor and
 // executes the call, returning the IResource instance or error.
 func invokeConstructor(ctor reflect.Value, client *databricks.WorkspaceClient, cfg any) (IResource, error) {
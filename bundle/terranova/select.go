@@ -0,0 +1,93 @@
+package terranova
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/databricks/cli/bundle/deployplan"
+	"github.com/databricks/cli/bundle/statemgmt/resourcestate"
+	"github.com/databricks/cli/libs/dagrun"
+	"github.com/databricks/cli/libs/dyn/dynvar"
+)
+
+// resourceSelector matches resources against patterns of the form "group.key", such as "jobs.foo".
+// Both parts of a pattern may contain glob wildcards, e.g. "jobs.etl_*" or "*.foo".
+type resourceSelector struct {
+	patterns []string
+}
+
+func newResourceSelector(patterns []string) (*resourceSelector, error) {
+	for _, pattern := range patterns {
+		group, key, ok := strings.Cut(pattern, ".")
+		if !ok || group == "" || key == "" {
+			return nil, fmt.Errorf("invalid resource selector %q: expected <group>.<key>, for example jobs.my_job or jobs.etl_*", pattern)
+		}
+		if _, err := path.Match(pattern, ""); err != nil {
+			return nil, fmt.Errorf("invalid resource selector %q: %w", pattern, err)
+		}
+	}
+	return &resourceSelector{patterns: patterns}, nil
+}
+
+func (s *resourceSelector) matches(node deployplan.ResourceNode) bool {
+	for _, pattern := range s.patterns {
+		if s.matchesPattern(pattern, node) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *resourceSelector) matchesPattern(pattern string, node deployplan.ResourceNode) bool {
+	group, key, _ := strings.Cut(pattern, ".")
+	groupMatches, _ := path.Match(group, node.Group)
+	keyMatches, _ := path.Match(key, node.Key)
+	return groupMatches && keyMatches
+}
+
+// selectNodes returns the nodes that match any of the patterns, in the order given.
+// It fails if a pattern does not match any node, because that is most likely a typo.
+func (s *resourceSelector) selectNodes(nodes []deployplan.ResourceNode) ([]deployplan.ResourceNode, error) {
+	var selected []deployplan.ResourceNode
+	for _, pattern := range s.patterns {
+		found := false
+		for _, node := range nodes {
+			if s.matchesPattern(pattern, node) {
+				found = true
+				selected = append(selected, node)
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("no resources match %q", pattern)
+		}
+	}
+	return selected, nil
+}
+
+// checkReferencesToNewIDs fails if a selected resource gets a new ID while a deployed resource that is not selected
+// references that ID. The resource that is not selected would keep referencing the old ID.
+func checkReferencesToNewIDs(graph, selected *dagrun.Graph[deployplan.ResourceNode], actions map[deployplan.ResourceNode]deployplan.ActionType, state resourcestate.ExportedResourcesMap) error {
+	isIDReference := func(label string) bool {
+		p, ok := dynvar.PureReferenceToPath(label)
+		return ok && len(p) == 4 && p[3].Key() == "id"
+	}
+
+	for _, node := range selected.Nodes() {
+		action, ok := actions[node]
+		if !ok || action.KeepsID() {
+			continue
+		}
+
+		for _, dependent := range graph.OutgoingNodes(node, isIDReference) {
+			if selected.HasNode(dependent) {
+				continue
+			}
+			if _, deployed := state[dependent.Group][dependent.Key]; !deployed {
+				continue
+			}
+			return fmt.Errorf("cannot deploy only %s: it is planned for %s, which changes its ID, and %s references its ID. Add %s to --only to update the reference", node, action, dependent, dependent)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,84 @@
+package terranova
+
+import (
+	"testing"
+
+	"github.com/databricks/cli/bundle/deployplan"
+	"github.com/databricks/cli/bundle/statemgmt/resourcestate"
+	"github.com/databricks/cli/libs/dagrun"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResourceSelectorInvalid(t *testing.T) {
+	for _, pattern := range []string{"jobs", "jobs.", ".foo", "jobs.[", ""} {
+		_, err := newResourceSelector([]string{pattern})
+		assert.ErrorContains(t, err, "invalid resource selector", pattern)
+	}
+}
+
+func TestResourceSelectorSelectNodes(t *testing.T) {
+	nodes := []deployplan.ResourceNode{
+		{Group: "jobs", Key: "etl_daily"},
+		{Group: "jobs", Key: "etl_hourly"},
+		{Group: "jobs", Key: "report"},
+		{Group: "pipelines", Key: "etl_daily"},
+		{Group: "pipelines", Key: "report"},
+	}
+
+	s, err := newResourceSelector([]string{"jobs.etl_*", "*.report"})
+	require.NoError(t, err)
+
+	selected, err := s.selectNodes(nodes)
+	require.NoError(t, err)
+	assert.Equal(t, []deployplan.ResourceNode{
+		{Group: "jobs", Key: "etl_daily"},
+		{Group: "jobs", Key: "etl_hourly"},
+		{Group: "jobs", Key: "report"},
+		{Group: "pipelines", Key: "report"},
+	}, selected)
+
+	assert.True(t, s.matches(deployplan.ResourceNode{Group: "jobs", Key: "etl_weekly"}))
+	assert.False(t, s.matches(deployplan.ResourceNode{Group: "pipelines", Key: "etl_daily"}))
+}
+
+func TestResourceSelectorNoMatch(t *testing.T) {
+	s, err := newResourceSelector([]string{"jobs.foo", "jobs.bar"})
+	require.NoError(t, err)
+
+	_, err = s.selectNodes([]deployplan.ResourceNode{{Group: "jobs", Key: "foo"}})
+	assert.EqualError(t, err, `no resources match "jobs.bar"`)
+}
+
+func TestCheckReferencesToNewIDs(t *testing.T) {
+	schema := deployplan.ResourceNode{Group: "schemas", Key: "data"}
+	consumer := deployplan.ResourceNode{Group: "jobs", Key: "consumer"}
+	reader := deployplan.ResourceNode{Group: "jobs", Key: "reader"}
+
+	graph := dagrun.NewGraph[deployplan.ResourceNode]()
+	graph.AddDirectedEdge(schema, consumer, "${resources.schemas.data.id}")
+	graph.AddDirectedEdge(schema, reader, "${resources.schemas.data.name}")
+	selected := graph.Subgraph([]deployplan.ResourceNode{schema})
+
+	state := resourcestate.ExportedResourcesMap{
+		"schemas": {"data": {ID: "main.data"}},
+		"jobs":    {"consumer": {ID: "1"}, "reader": {ID: "2"}},
+	}
+
+	// The ID is kept, so the reference stays valid.
+	actions := map[deployplan.ResourceNode]deployplan.ActionType{schema: deployplan.ActionTypeUpdate}
+	assert.NoError(t, checkReferencesToNewIDs(graph, selected, actions, state))
+
+	actions[schema] = deployplan.ActionTypeRecreate
+	err := checkReferencesToNewIDs(graph, selected, actions, state)
+	assert.EqualError(t, err, "cannot deploy only schemas.data: it is planned for recreate, which changes its ID, and jobs.consumer references its ID. Add jobs.consumer to --only to update the reference")
+
+	// Resources that were not deployed yet don't reference the old ID.
+	delete(state["jobs"], "consumer")
+	assert.NoError(t, checkReferencesToNewIDs(graph, selected, actions, state))
+
+	// Selected resources are planned with the new ID.
+	state["jobs"]["consumer"] = resourcestate.ResourceState{ID: "1"}
+	selected = graph.Subgraph([]deployplan.ResourceNode{schema, consumer})
+	assert.NoError(t, checkReferencesToNewIDs(graph, selected, actions, state))
+}
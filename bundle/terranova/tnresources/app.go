@@ -63,6 +63,11 @@ func DeleteApp(ctx context.Context, client *databricks.WorkspaceClient, id strin
 	return err
 }
 
+// ReadApp returns the app as it is in the workspace.
+func ReadApp(ctx context.Context, client *databricks.WorkspaceClient, id string) (any, error) {
+	return client.Apps.GetByName(ctx, id)
+}
+
 func (r *ResourceApp) WaitAfterCreate(ctx context.Context) error {
 	_, err := r.waitForApp(ctx, r.client, r.config.Name)
 	if err != nil {
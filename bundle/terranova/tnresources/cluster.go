@@ -0,0 +1,198 @@
+package tnresources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/databricks/cli/bundle/config/resources"
+	"github.com/databricks/cli/bundle/deployplan"
+	"github.com/databricks/cli/libs/log"
+	"github.com/databricks/cli/libs/structdiff"
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/databricks-sdk-go/apierr"
+	"github.com/databricks/databricks-sdk-go/retries"
+	"github.com/databricks/databricks-sdk-go/service/compute"
+)
+
+// Same timeout as TF provider uses when retrying edits of clusters that are being resized or restarted:
+// https://github.com/databricks/terraform-provider-databricks/blob/3eecd0f/clusters/resource_cluster.go#L624
+const clusterEditTimeout = 15 * time.Minute
+
+type ResourceCluster struct {
+	client *databricks.WorkspaceClient
+	config compute.ClusterSpec
+}
+
+func NewResourceCluster(client *databricks.WorkspaceClient, config *resources.Cluster) (*ResourceCluster, error) {
+	return &ResourceCluster{
+		client: client,
+		config: config.ClusterSpec,
+	}, nil
+}
+
+func (r *ResourceCluster) Config() any {
+	return r.config
+}
+
+func (r *ResourceCluster) DoCreate(ctx context.Context) (string, error) {
+	waiter, err := r.client.Clusters.Create(ctx, makeCreateCluster(r.config))
+	if err != nil {
+		return "", err
+	}
+	return waiter.ClusterId, nil
+}
+
+// DoUpdate edits the cluster. If the cluster is running, the backend restarts it to apply the new settings.
+// If it is terminated, it stays terminated and picks up the new settings on next start.
+func (r *ResourceCluster) DoUpdate(ctx context.Context, id string) error {
+	request := makeEditCluster(r.config, id)
+	return retries.Wait(ctx, clusterEditTimeout, func() *retries.Err {
+		_, err := r.client.Clusters.Edit(ctx, request)
+		if err == nil {
+			return nil
+		}
+		// Only running and terminated clusters can be edited. In particular, clusters that are being
+		// resized or restarted reject edits with INVALID_STATE until the operation is complete.
+		if errors.Is(err, apierr.ErrInvalidState) {
+			log.Debugf(ctx, "clusters: cannot edit cluster %s in current state, retrying: %s", id, err)
+			return retries.Continues(fmt.Sprintf("cluster %s cannot be edited in its current state", id))
+		}
+		return retries.Halt(err)
+	})
+}
+
+// DoResize changes the number of workers of a running cluster without restarting it.
+// The resize API is only available for running clusters, so we fall back to edit otherwise.
+func (r *ResourceCluster) DoResize(ctx context.Context, id string) error {
+	details, err := r.client.Clusters.GetByClusterId(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if details.State != compute.StateRunning {
+		log.Debugf(ctx, "clusters: cluster %s is in state %s, using edit instead of resize", id, details.State)
+		return r.DoUpdate(ctx, id)
+	}
+
+	_, err = r.client.Clusters.Resize(ctx, compute.ResizeCluster{
+		Autoscale:       r.config.Autoscale,
+		ClusterId:       id,
+		NumWorkers:      r.config.NumWorkers,
+		ForceSendFields: filterFields[compute.ResizeCluster](r.config.ForceSendFields),
+	})
+	return err
+}
+
+func DeleteCluster(ctx context.Context, client *databricks.WorkspaceClient, id string) error {
+	return client.Clusters.PermanentDeleteByClusterId(ctx, id)
+}
+
+// ReadCluster returns the cluster as it is in the workspace.
+func ReadCluster(ctx context.Context, client *databricks.WorkspaceClient, id string) (any, error) {
+	return client.Clusters.GetByClusterId(ctx, id)
+}
+
+func (r *ResourceCluster) WaitAfterCreate(ctx context.Context) error {
+	// Intentional no-op: same as no_wait=true we set for TF, we don't wait for the cluster to start.
+	return nil
+}
+
+func (r *ResourceCluster) WaitAfterUpdate(ctx context.Context) error {
+	// Intentional no-op: restarts triggered by edit happen in the background.
+	return nil
+}
+
+func (r *ResourceCluster) ClassifyChanges(changes []structdiff.Change) deployplan.ActionType {
+	for _, change := range changes {
+		if !isClusterSizeField(change.Path.String()) {
+			return deployplan.ActionTypeUpdate
+		}
+	}
+	return deployplan.ActionTypeResize
+}
+
+// isClusterSizeField returns true for fields that can be changed with the resize API.
+func isClusterSizeField(path string) bool {
+	return path == ".num_workers" || path == ".autoscale" || strings.HasPrefix(path, ".autoscale.")
+}
+
+func makeCreateCluster(config compute.ClusterSpec) compute.CreateCluster {
+	return compute.CreateCluster{
+		ApplyPolicyDefaultValues:   config.ApplyPolicyDefaultValues,
+		Autoscale:                  config.Autoscale,
+		AutoterminationMinutes:     config.AutoterminationMinutes,
+		AwsAttributes:              config.AwsAttributes,
+		AzureAttributes:            config.AzureAttributes,
+		CloneFrom:                  nil, // Not supported by DABs
+		ClusterLogConf:             config.ClusterLogConf,
+		ClusterName:                config.ClusterName,
+		CustomTags:                 config.CustomTags,
+		DataSecurityMode:           config.DataSecurityMode,
+		DockerImage:                config.DockerImage,
+		DriverInstancePoolId:       config.DriverInstancePoolId,
+		DriverNodeTypeId:           config.DriverNodeTypeId,
+		EnableElasticDisk:          config.EnableElasticDisk,
+		EnableLocalDiskEncryption:  config.EnableLocalDiskEncryption,
+		GcpAttributes:              config.GcpAttributes,
+		InitScripts:                config.InitScripts,
+		InstancePoolId:             config.InstancePoolId,
+		IsSingleNode:               config.IsSingleNode,
+		Kind:                       config.Kind,
+		NodeTypeId:                 config.NodeTypeId,
+		NumWorkers:                 config.NumWorkers,
+		PolicyId:                   config.PolicyId,
+		RemoteDiskThroughput:       config.RemoteDiskThroughput,
+		RuntimeEngine:              config.RuntimeEngine,
+		SingleUserName:             config.SingleUserName,
+		SparkConf:                  config.SparkConf,
+		SparkEnvVars:               config.SparkEnvVars,
+		SparkVersion:               config.SparkVersion,
+		SshPublicKeys:              config.SshPublicKeys,
+		TotalInitialRemoteDiskSize: config.TotalInitialRemoteDiskSize,
+		UseMlRuntime:               config.UseMlRuntime,
+		WorkloadType:               config.WorkloadType,
+		ForceSendFields:            filterFields[compute.CreateCluster](config.ForceSendFields),
+	}
+}
+
+func makeEditCluster(config compute.ClusterSpec, id string) compute.EditCluster {
+	return compute.EditCluster{
+		ApplyPolicyDefaultValues:   config.ApplyPolicyDefaultValues,
+		Autoscale:                  config.Autoscale,
+		AutoterminationMinutes:     config.AutoterminationMinutes,
+		AwsAttributes:              config.AwsAttributes,
+		AzureAttributes:            config.AzureAttributes,
+		ClusterId:                  id,
+		ClusterLogConf:             config.ClusterLogConf,
+		ClusterName:                config.ClusterName,
+		CustomTags:                 config.CustomTags,
+		DataSecurityMode:           config.DataSecurityMode,
+		DockerImage:                config.DockerImage,
+		DriverInstancePoolId:       config.DriverInstancePoolId,
+		DriverNodeTypeId:           config.DriverNodeTypeId,
+		EnableElasticDisk:          config.EnableElasticDisk,
+		EnableLocalDiskEncryption:  config.EnableLocalDiskEncryption,
+		GcpAttributes:              config.GcpAttributes,
+		InitScripts:                config.InitScripts,
+		InstancePoolId:             config.InstancePoolId,
+		IsSingleNode:               config.IsSingleNode,
+		Kind:                       config.Kind,
+		NodeTypeId:                 config.NodeTypeId,
+		NumWorkers:                 config.NumWorkers,
+		PolicyId:                   config.PolicyId,
+		RemoteDiskThroughput:       config.RemoteDiskThroughput,
+		RuntimeEngine:              config.RuntimeEngine,
+		SingleUserName:             config.SingleUserName,
+		SparkConf:                  config.SparkConf,
+		SparkEnvVars:               config.SparkEnvVars,
+		SparkVersion:               config.SparkVersion,
+		SshPublicKeys:              config.SshPublicKeys,
+		TotalInitialRemoteDiskSize: config.TotalInitialRemoteDiskSize,
+		UseMlRuntime:               config.UseMlRuntime,
+		WorkloadType:               config.WorkloadType,
+		ForceSendFields:            filterFields[compute.EditCluster](config.ForceSendFields),
+	}
+}
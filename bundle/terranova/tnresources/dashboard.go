@@ -0,0 +1,158 @@
+package tnresources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/databricks/cli/bundle/config/resources"
+	"github.com/databricks/cli/libs/log"
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/databricks-sdk-go/service/dashboards"
+)
+
+// DashboardState is the part of the dashboard configuration that is deployed and stored in state.
+// We don't use dashboards.Dashboard directly because it mixes settings with output-only fields
+// (etag, path, lifecycle_state, ...) and because embed_credentials is a setting of the publish call.
+type DashboardState struct {
+	DisplayName         string `json:"display_name,omitempty"`
+	EmbedCredentials    bool   `json:"embed_credentials,omitempty"`
+	ParentPath          string `json:"parent_path,omitempty"`
+	SerializedDashboard string `json:"serialized_dashboard,omitempty"`
+	WarehouseId         string `json:"warehouse_id,omitempty"`
+}
+
+type ResourceDashboard struct {
+	client *databricks.WorkspaceClient
+	config DashboardState
+
+	// ID and etag returned by the last create or update call.
+	// The dashboard is published in WaitAfterCreate/WaitAfterUpdate, after the ID is saved to state.
+	id   string
+	etag string
+}
+
+func NewResourceDashboard(client *databricks.WorkspaceClient, resource *resources.Dashboard) (*ResourceDashboard, error) {
+	serializedDashboard, err := serializeDashboard(resource.SerializedDashboard)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ResourceDashboard{
+		client: client,
+		config: DashboardState{
+			DisplayName:         resource.DisplayName,
+			EmbedCredentials:    resource.EmbedCredentials,
+			ParentPath:          resource.ParentPath,
+			SerializedDashboard: serializedDashboard,
+			WarehouseId:         resource.WarehouseId,
+		},
+		id:   "",
+		etag: "",
+	}, nil
+}
+
+func (r *ResourceDashboard) Config() any {
+	return r.config
+}
+
+func (r *ResourceDashboard) DoCreate(ctx context.Context) (string, error) {
+	response, err := r.client.Lakeview.Create(ctx, dashboards.CreateDashboardRequest{
+		Dashboard: r.makeDashboard(),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	r.id = response.DashboardId
+	r.etag = response.Etag
+	return response.DashboardId, nil
+}
+
+func (r *ResourceDashboard) DoUpdate(ctx context.Context, id string) error {
+	dashboard := r.makeDashboard()
+
+	// Parent path cannot be changed by Update(). We recreate dashboards on parent_path change.
+	dashboard.ParentPath = ""
+
+	response, err := r.client.Lakeview.Update(ctx, dashboards.UpdateDashboardRequest{
+		Dashboard:   dashboard,
+		DashboardId: id,
+	})
+	if err != nil {
+		return err
+	}
+
+	if response.DashboardId != "" && response.DashboardId != id {
+		log.Warnf(ctx, "dashboards: response contains unexpected dashboard_id=%#v (expected %#v)", response.DashboardId, id)
+	}
+
+	r.id = id
+	r.etag = response.Etag
+	return nil
+}
+
+// GetETag returns the etag of the dashboard draft after the last create or update.
+// It is stored in the state to detect modifications made outside of the bundle.
+func (r *ResourceDashboard) GetETag() string {
+	return r.etag
+}
+
+func DeleteDashboard(ctx context.Context, client *databricks.WorkspaceClient, id string) error {
+	return client.Lakeview.TrashByDashboardId(ctx, id)
+}
+
+func (r *ResourceDashboard) WaitAfterCreate(ctx context.Context) error {
+	return r.publish(ctx)
+}
+
+func (r *ResourceDashboard) WaitAfterUpdate(ctx context.Context) error {
+	return r.publish(ctx)
+}
+
+func (r *ResourceDashboard) makeDashboard() dashboards.Dashboard {
+	return dashboards.Dashboard{
+		CreateTime:          "", // Output only
+		DashboardId:         "", // Output only
+		DisplayName:         r.config.DisplayName,
+		Etag:                "", // We overwrite the dashboard unconditionally, remote modifications are checked before deploy.
+		LifecycleState:      "", // Output only
+		ParentPath:          r.config.ParentPath,
+		Path:                "", // Output only
+		SerializedDashboard: r.config.SerializedDashboard,
+		UpdateTime:          "", // Output only
+		WarehouseId:         r.config.WarehouseId,
+		ForceSendFields:     nil,
+	}
+}
+
+// publish publishes the current draft of the dashboard, same as the TF provider does after each create and update.
+func (r *ResourceDashboard) publish(ctx context.Context) error {
+	_, err := r.client.Lakeview.Publish(ctx, dashboards.PublishRequest{
+		DashboardId:      r.id,
+		EmbedCredentials: r.config.EmbedCredentials,
+		WarehouseId:      r.config.WarehouseId,
+		ForceSendFields:  []string{"EmbedCredentials"},
+	})
+	if err != nil {
+		return fmt.Errorf("publishing: %w", err)
+	}
+	return nil
+}
+
+// serializeDashboard converts serialized_dashboard from the bundle config to a string.
+// If the value is a string, it is used as is. Otherwise it was inlined in YAML and is marshalled as JSON.
+func serializeDashboard(value any) (string, error) {
+	switch v := value.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return v, nil
+	default:
+		data, err := json.Marshal(v)
+		if err != nil {
+			return "", fmt.Errorf("failed to marshal serialized_dashboard: %w", err)
+		}
+		return string(data), nil
+	}
+}
@@ -0,0 +1,90 @@
+package tnresources
+
+import (
+	"context"
+
+	"github.com/databricks/cli/bundle/config/resources"
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/databricks-sdk-go/service/ml"
+)
+
+type ResourceExperiment struct {
+	client *databricks.WorkspaceClient
+	config ml.CreateExperiment
+}
+
+func NewResourceExperiment(client *databricks.WorkspaceClient, resource *resources.MlflowExperiment) (*ResourceExperiment, error) {
+	return &ResourceExperiment{
+		client: client,
+		config: ml.CreateExperiment{
+			ArtifactLocation: resource.ArtifactLocation,
+			Name:             resource.Name,
+			Tags:             resource.Tags,
+			ForceSendFields:  filterFields[ml.CreateExperiment](resource.ForceSendFields),
+		},
+	}, nil
+}
+
+func (r *ResourceExperiment) Config() any {
+	return r.config
+}
+
+func (r *ResourceExperiment) DoCreate(ctx context.Context) (string, error) {
+	response, err := r.client.Experiments.CreateExperiment(ctx, r.config)
+	if err != nil {
+		return "", err
+	}
+	return response.ExperimentId, nil
+}
+
+func (r *ResourceExperiment) DoUpdate(ctx context.Context, id string) error {
+	err := r.client.Experiments.UpdateExperiment(ctx, ml.UpdateExperiment{
+		ExperimentId:    id,
+		NewName:         r.config.Name,
+		ForceSendFields: nil,
+	})
+	if err != nil {
+		return err
+	}
+
+	// There is no API to delete experiment tags, so tags removed from the config are kept on the experiment.
+	for _, tag := range r.config.Tags {
+		err := r.client.Experiments.SetExperimentTag(ctx, ml.SetExperimentTag{
+			ExperimentId: id,
+			Key:          tag.Key,
+			Value:        tag.Value,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func DeleteExperiment(ctx context.Context, client *databricks.WorkspaceClient, id string) error {
+	return client.Experiments.DeleteExperiment(ctx, ml.DeleteExperiment{
+		ExperimentId: id,
+	})
+}
+
+// ReadExperiment returns the experiment as it is in the workspace.
+func ReadExperiment(ctx context.Context, client *databricks.WorkspaceClient, id string) (any, error) {
+	response, err := client.Experiments.GetExperiment(ctx, ml.GetExperimentRequest{
+		ExperimentId: id,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return response.Experiment, nil
+}
+
+func (r *ResourceExperiment) WaitAfterCreate(ctx context.Context) error {
+	// Intentional no-op
+	return nil
+}
+
+func (r *ResourceExperiment) WaitAfterUpdate(ctx context.Context) error {
+	// Intentional no-op
+	return nil
+}
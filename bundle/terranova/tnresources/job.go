@@ -53,6 +53,19 @@ func DeleteJob(ctx context.Context, client *databricks.WorkspaceClient, id strin
 	return client.Jobs.DeleteByJobId(ctx, idInt)
 }
 
+// ReadJob returns the settings of the job as they are in the workspace.
+func ReadJob(ctx context.Context, client *databricks.WorkspaceClient, id string) (any, error) {
+	idInt, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	job, err := client.Jobs.GetByJobId(ctx, idInt)
+	if err != nil {
+		return nil, err
+	}
+	return job.Settings, nil
+}
+
 func (r *ResourceJob) WaitAfterCreate(ctx context.Context) error {
 	// Intentional no-op
 	return nil
@@ -0,0 +1,143 @@
+package tnresources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/databricks/cli/bundle/config/resources"
+	"github.com/databricks/cli/bundle/deployplan"
+	"github.com/databricks/cli/libs/structdiff"
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/databricks-sdk-go/service/ml"
+)
+
+type ResourceModel struct {
+	client *databricks.WorkspaceClient
+	config ml.CreateModelRequest
+}
+
+func NewResourceModel(client *databricks.WorkspaceClient, resource *resources.MlflowModel) (*ResourceModel, error) {
+	return &ResourceModel{
+		client: client,
+		config: resource.CreateModelRequest,
+	}, nil
+}
+
+func (r *ResourceModel) Config() any {
+	return r.config
+}
+
+func (r *ResourceModel) DoCreate(ctx context.Context) (string, error) {
+	response, err := r.client.ModelRegistry.CreateModel(ctx, r.config)
+	if err != nil {
+		return "", err
+	}
+	if response.RegisteredModel == nil {
+		return r.config.Name, nil
+	}
+	return response.RegisteredModel.Name, nil
+}
+
+func (r *ResourceModel) DoUpdate(ctx context.Context, id string) error {
+	if r.config.Name != id {
+		return fmt.Errorf("internal error: unexpected change of name from %#v to %#v", id, r.config.Name)
+	}
+	return r.update(ctx, id)
+}
+
+func (r *ResourceModel) DoUpdateWithID(ctx context.Context, id string) (string, error) {
+	newID := id
+	if r.config.Name != id {
+		response, err := r.client.ModelRegistry.RenameModel(ctx, ml.RenameModelRequest{
+			Name:            id,
+			NewName:         r.config.Name,
+			ForceSendFields: nil,
+		})
+		if err != nil {
+			return "", err
+		}
+		newID = r.config.Name
+		if response.RegisteredModel != nil {
+			newID = response.RegisteredModel.Name
+		}
+	}
+
+	return newID, r.update(ctx, newID)
+}
+
+// update applies description and tags to the model with the given name.
+func (r *ResourceModel) update(ctx context.Context, name string) error {
+	_, err := r.client.ModelRegistry.UpdateModel(ctx, ml.UpdateModelRequest{
+		Description:     r.config.Description,
+		Name:            name,
+		ForceSendFields: filterFields[ml.UpdateModelRequest](r.config.ForceSendFields),
+	})
+	if err != nil {
+		return err
+	}
+
+	response, err := r.client.ModelRegistry.GetModel(ctx, ml.GetModelRequest{
+		Name: name,
+	})
+	if err != nil {
+		return err
+	}
+
+	newTags := make(map[string]bool, len(r.config.Tags))
+	for _, tag := range r.config.Tags {
+		newTags[tag.Key] = true
+	}
+
+	if response.RegisteredModelDatabricks != nil {
+		for _, tag := range response.RegisteredModelDatabricks.Tags {
+			if newTags[tag.Key] {
+				continue
+			}
+			err := r.client.ModelRegistry.DeleteModelTag(ctx, ml.DeleteModelTagRequest{
+				Key:  tag.Key,
+				Name: name,
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, tag := range r.config.Tags {
+		err := r.client.ModelRegistry.SetModelTag(ctx, ml.SetModelTagRequest{
+			Key:   tag.Key,
+			Name:  name,
+			Value: tag.Value,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func DeleteModel(ctx context.Context, client *databricks.WorkspaceClient, id string) error {
+	return client.ModelRegistry.DeleteModel(ctx, ml.DeleteModelRequest{
+		Name: id,
+	})
+}
+
+func (r *ResourceModel) WaitAfterCreate(ctx context.Context) error {
+	// Intentional no-op
+	return nil
+}
+
+func (r *ResourceModel) WaitAfterUpdate(ctx context.Context) error {
+	// Intentional no-op
+	return nil
+}
+
+func (r *ResourceModel) ClassifyChanges(changes []structdiff.Change) deployplan.ActionType {
+	for _, change := range changes {
+		if change.Path.String() == ".name" {
+			return deployplan.ActionTypeUpdateWithID
+		}
+	}
+	return deployplan.ActionTypeUpdate
+}
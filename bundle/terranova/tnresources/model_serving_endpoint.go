@@ -0,0 +1,156 @@
+package tnresources
+
+import (
+	"context"
+	"time"
+
+	"github.com/databricks/cli/bundle/config/resources"
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/databricks-sdk-go/service/serving"
+)
+
+// Same as the default provisioning timeout of the TF provider for databricks_model_serving.
+const servingEndpointTimeout = 45 * time.Minute
+
+type ResourceModelServingEndpoint struct {
+	client *databricks.WorkspaceClient
+	config serving.CreateServingEndpoint
+
+	// Rate limits of the last deployment. They cannot be read from the endpoint,
+	// but must be known to clear them when they are removed from the config.
+	savedRateLimits []serving.RateLimit
+}
+
+func NewResourceModelServingEndpoint(client *databricks.WorkspaceClient, resource *resources.ModelServingEndpoint) (*ResourceModelServingEndpoint, error) {
+	return &ResourceModelServingEndpoint{
+		client: client,
+		config: resource.CreateServingEndpoint,
+	}, nil
+}
+
+func (r *ResourceModelServingEndpoint) Config() any {
+	return r.config
+}
+
+func (r *ResourceModelServingEndpoint) SetSavedState(state any) {
+	if saved, ok := state.(serving.CreateServingEndpoint); ok {
+		r.savedRateLimits = saved.RateLimits
+	}
+}
+
+func (r *ResourceModelServingEndpoint) DoCreate(ctx context.Context) (string, error) {
+	waiter, err := r.client.ServingEndpoints.Create(ctx, r.config)
+	if err != nil {
+		return "", err
+	}
+	return waiter.Name, nil
+}
+
+// DoUpdate updates the parts of the endpoint that have a dedicated API: served entities and traffic (config),
+// tags, rate limits and AI gateway. Changes to other fields, including any field of email_notifications, trigger a recreate.
+func (r *ResourceModelServingEndpoint) DoUpdate(ctx context.Context, id string) error {
+	remote, err := r.client.ServingEndpoints.GetByName(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if r.config.Config != nil {
+		config := *r.config.Config
+		config.Name = id
+		_, err = r.client.ServingEndpoints.UpdateConfig(ctx, config)
+		if err != nil {
+			return err
+		}
+
+		// Other updates are rejected while the config update is in progress.
+		_, err = r.client.ServingEndpoints.WaitGetServingEndpointNotUpdating(ctx, id, servingEndpointTimeout, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = r.updateTags(ctx, id, remote.Tags)
+	if err != nil {
+		return err
+	}
+
+	// An empty list removes the rate limits of the last deployment.
+	if len(r.config.RateLimits) > 0 || len(r.savedRateLimits) > 0 {
+		_, err = r.client.ServingEndpoints.Put(ctx, serving.PutRequest{
+			Name:       id,
+			RateLimits: r.config.RateLimits,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	if r.config.AiGateway != nil || remote.AiGateway != nil {
+		_, err = r.client.ServingEndpoints.PutAiGateway(ctx, makePutAiGateway(id, r.config.AiGateway))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *ResourceModelServingEndpoint) updateTags(ctx context.Context, id string, remoteTags []serving.EndpointTag) error {
+	newTags := make(map[string]bool, len(r.config.Tags))
+	for _, tag := range r.config.Tags {
+		newTags[tag.Key] = true
+	}
+
+	var deleteTags []string
+	for _, tag := range remoteTags {
+		if !newTags[tag.Key] {
+			deleteTags = append(deleteTags, tag.Key)
+		}
+	}
+
+	if len(r.config.Tags) == 0 && len(deleteTags) == 0 {
+		return nil
+	}
+
+	_, err := r.client.ServingEndpoints.Patch(ctx, serving.PatchServingEndpointTags{
+		AddTags:    r.config.Tags,
+		DeleteTags: deleteTags,
+		Name:       id,
+	})
+	return err
+}
+
+func DeleteModelServingEndpoint(ctx context.Context, client *databricks.WorkspaceClient, id string) error {
+	return client.ServingEndpoints.DeleteByName(ctx, id)
+}
+
+func (r *ResourceModelServingEndpoint) WaitAfterCreate(ctx context.Context) error {
+	_, err := r.client.ServingEndpoints.WaitGetServingEndpointNotUpdating(ctx, r.config.Name, servingEndpointTimeout, nil)
+	return err
+}
+
+func (r *ResourceModelServingEndpoint) WaitAfterUpdate(ctx context.Context) error {
+	_, err := r.client.ServingEndpoints.WaitGetServingEndpointNotUpdating(ctx, r.config.Name, servingEndpointTimeout, nil)
+	return err
+}
+
+// makePutAiGateway returns a request that sets the AI gateway to the given config. A nil config disables all features.
+func makePutAiGateway(id string, config *serving.AiGatewayConfig) serving.PutAiGatewayRequest {
+	if config == nil {
+		config = &serving.AiGatewayConfig{
+			FallbackConfig:       nil,
+			Guardrails:           nil,
+			InferenceTableConfig: nil,
+			RateLimits:           nil,
+			UsageTrackingConfig:  nil,
+		}
+	}
+	return serving.PutAiGatewayRequest{
+		FallbackConfig:       config.FallbackConfig,
+		Guardrails:           config.Guardrails,
+		InferenceTableConfig: config.InferenceTableConfig,
+		Name:                 id,
+		RateLimits:           config.RateLimits,
+		UsageTrackingConfig:  config.UsageTrackingConfig,
+	}
+}
@@ -75,6 +75,15 @@ func DeletePipeline(ctx context.Context, client *databricks.WorkspaceClient, id
 	return client.Pipelines.DeleteByPipelineId(ctx, id)
 }
 
+// ReadPipeline returns the spec of the pipeline as it is in the workspace.
+func ReadPipeline(ctx context.Context, client *databricks.WorkspaceClient, id string) (any, error) {
+	pipeline, err := client.Pipelines.GetByPipelineId(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return pipeline.Spec, nil
+}
+
 func (r *ResourcePipeline) WaitAfterCreate(ctx context.Context) error {
 	// Note, terraform provider either
 	// a) reads back state at least once and fails create if state is "failed"
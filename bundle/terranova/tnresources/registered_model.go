@@ -0,0 +1,122 @@
+package tnresources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/databricks/cli/bundle/config/resources"
+	"github.com/databricks/cli/bundle/deployplan"
+	"github.com/databricks/cli/libs/log"
+	"github.com/databricks/cli/libs/structdiff"
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/databricks-sdk-go/service/catalog"
+)
+
+type ResourceRegisteredModel struct {
+	client *databricks.WorkspaceClient
+	config catalog.CreateRegisteredModelRequest
+}
+
+func NewResourceRegisteredModel(client *databricks.WorkspaceClient, resource *resources.RegisteredModel) (*ResourceRegisteredModel, error) {
+	return &ResourceRegisteredModel{
+		client: client,
+		config: resource.CreateRegisteredModelRequest,
+	}, nil
+}
+
+func (r *ResourceRegisteredModel) Config() any {
+	return r.config
+}
+
+func (r *ResourceRegisteredModel) DoCreate(ctx context.Context) (string, error) {
+	response, err := r.client.RegisteredModels.Create(ctx, r.config)
+	if err != nil {
+		return "", err
+	}
+	return response.FullName, nil
+}
+
+func (r *ResourceRegisteredModel) DoUpdate(ctx context.Context, id string) error {
+	nameFromID, err := getNameFromID(id)
+	if err != nil {
+		return err
+	}
+
+	if r.config.Name != nameFromID {
+		return fmt.Errorf("internal error: unexpected change of name from %#v to %#v", nameFromID, r.config.Name)
+	}
+
+	response, err := r.client.RegisteredModels.Update(ctx, catalog.UpdateRegisteredModelRequest{
+		Comment:  r.config.Comment,
+		FullName: id,
+		NewName:  "", // Not supported by Update(). Needs DoUpdateWithID()
+		Owner:    "", // Not supported by DABs
+
+		ForceSendFields: filterFields[catalog.UpdateRegisteredModelRequest](r.config.ForceSendFields),
+	})
+	if err != nil {
+		return err
+	}
+
+	if id != response.FullName {
+		log.Warnf(ctx, "registered_models: response contains unexpected full_name=%#v (expected %#v)", response.FullName, id)
+	}
+
+	return nil
+}
+
+func (r *ResourceRegisteredModel) DoUpdateWithID(ctx context.Context, id string) (string, error) {
+	updateRequest := catalog.UpdateRegisteredModelRequest{
+		Comment:  r.config.Comment,
+		FullName: id,
+
+		NewName: "", // Initialized below if needed
+		Owner:   "", // Not supported by DABs
+
+		ForceSendFields: filterFields[catalog.UpdateRegisteredModelRequest](r.config.ForceSendFields),
+	}
+
+	nameFromID, err := getNameFromID(id)
+	if err != nil {
+		return "", err
+	}
+
+	if r.config.Name != nameFromID {
+		updateRequest.NewName = r.config.Name
+	}
+
+	response, err := r.client.RegisteredModels.Update(ctx, updateRequest)
+	if err != nil {
+		return "", err
+	}
+
+	return response.FullName, nil
+}
+
+func DeleteRegisteredModel(ctx context.Context, client *databricks.WorkspaceClient, id string) error {
+	return client.RegisteredModels.DeleteByFullName(ctx, id)
+}
+
+// ReadRegisteredModel returns the registered model as it is in the metastore.
+func ReadRegisteredModel(ctx context.Context, client *databricks.WorkspaceClient, id string) (any, error) {
+	return client.RegisteredModels.GetByFullName(ctx, id)
+}
+
+func (r *ResourceRegisteredModel) WaitAfterCreate(ctx context.Context) error {
+	// Intentional no-op
+	return nil
+}
+
+func (r *ResourceRegisteredModel) WaitAfterUpdate(ctx context.Context) error {
+	// Intentional no-op
+	return nil
+}
+
+func (r *ResourceRegisteredModel) ClassifyChanges(changes []structdiff.Change) deployplan.ActionType {
+	for _, change := range changes {
+		if change.Path.String() == ".name" {
+			return deployplan.ActionTypeUpdateWithID
+		}
+	}
+	return deployplan.ActionTypeUpdate
+}
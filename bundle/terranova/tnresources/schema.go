@@ -64,6 +64,11 @@ func DeleteSchema(ctx context.Context, client *databricks.WorkspaceClient, id st
 	})
 }
 
+// ReadSchema returns the schema as it is in the metastore.
+func ReadSchema(ctx context.Context, client *databricks.WorkspaceClient, id string) (any, error) {
+	return client.Schemas.GetByFullName(ctx, id)
+}
+
 func (r *ResourceSchema) WaitAfterCreate(ctx context.Context) error {
 	// Intentional no-op
 	return nil
@@ -101,6 +101,11 @@ func DeleteVolume(ctx context.Context, client *databricks.WorkspaceClient, id st
 	return client.Volumes.DeleteByName(ctx, id)
 }
 
+// ReadVolume returns the volume as it is in the metastore.
+func ReadVolume(ctx context.Context, client *databricks.WorkspaceClient, id string) (any, error) {
+	return client.Volumes.ReadByName(ctx, id)
+}
+
 func (r *ResourceVolume) WaitAfterCreate(ctx context.Context) error {
 	// Intentional no-op
 	return nil
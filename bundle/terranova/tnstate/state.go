@@ -25,10 +25,11 @@ type Database struct {
 
 type ResourceEntry struct {
 	ID    string `json:"__id__"`
+	ETag  string `json:"etag,omitempty"`
 	State any    `json:"state"`
 }
 
-func (db *TerranovaState) SaveState(group, resourceName, newID string, state any) error {
+func (db *TerranovaState) SaveState(group, resourceName, newID, etag string, state any) error {
 	db.AssertOpened()
 	db.mu.Lock()
 	defer db.mu.Unlock()
@@ -41,6 +42,7 @@ func (db *TerranovaState) SaveState(group, resourceName, newID string, state any
 
 	groupData[resourceName] = ResourceEntry{
 		ID:    newID,
+		ETag:  etag,
 		State: state,
 	}
 
@@ -93,9 +95,11 @@ func (db *TerranovaState) Open(path string) error {
 	data, err := os.ReadFile(path)
 	if err != nil {
 		if os.IsNotExist(err) {
+			// Lineage is assigned on first save, so that plans calculated against a not yet
+			// existing state can be matched against the state at deploy time.
 			db.Data = Database{
 				Serial:    0,
-				Lineage:   uuid.New().String(),
+				Lineage:   "",
 				Resources: make(map[string]map[string]ResourceEntry),
 			}
 			db.Path = path
@@ -113,10 +117,17 @@ func (db *TerranovaState) Open(path string) error {
 	return nil
 }
 
+// Finalize writes the state to disk. Every write increments the serial, so that
+// changes made by other deployments can be detected.
 func (db *TerranovaState) Finalize() error {
 	db.mu.Lock()
 	defer db.mu.Unlock()
 
+	if db.Data.Lineage == "" {
+		db.Data.Lineage = uuid.New().String()
+	}
+	db.Data.Serial++
+
 	return db.unlockedSave()
 }
 
@@ -133,8 +144,8 @@ func (db *TerranovaState) ExportState(ctx context.Context) resourcestate.Exporte
 		result[groupName] = resultGroup
 		for resourceName, entry := range group {
 			resultGroup[resourceName] = resourcestate.ResourceState{
-				ID: entry.ID,
-				// TODO: extract Etag
+				ID:   entry.ID,
+				ETag: entry.ETag,
 			}
 		}
 	}
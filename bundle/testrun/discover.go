@@ -0,0 +1,41 @@
+package testrun
+
+import (
+	"path"
+	"strings"
+)
+
+// isTestFile reports whether the file is collected by pytest by default, i.e. matches test_*.py or *_test.py.
+func isTestFile(name string) bool {
+	base := path.Base(name)
+	if !strings.HasSuffix(base, ".py") {
+		return false
+	}
+	return strings.HasPrefix(base, "test_") || strings.HasSuffix(base, "_test.py")
+}
+
+// Discover returns the test files among files, which are slash-separated paths relative to the sync root.
+// If filters is not empty, only test files that are equal to or located under one of the filters are returned.
+func Discover(files, filters []string) []string {
+	var result []string
+	for _, file := range files {
+		if !isTestFile(file) {
+			continue
+		}
+		if len(filters) > 0 && !matchesAny(file, filters) {
+			continue
+		}
+		result = append(result, file)
+	}
+	return result
+}
+
+func matchesAny(file string, filters []string) bool {
+	for _, filter := range filters {
+		filter = strings.TrimSuffix(filter, "/")
+		if filter == "." || file == filter || strings.HasPrefix(file, filter+"/") {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,39 @@
+package testrun
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiscover(t *testing.T) {
+	files := []string{
+		"databricks.yml",
+		"src/my_project/main.py",
+		"src/my_project/main_test.py",
+		"tests/conftest.py",
+		"tests/test_main.py",
+		"tests/integration/test_pipeline.py",
+		"tests/test_data.csv",
+		"notebooks/test_notebook.ipynb",
+	}
+
+	assert.Equal(t, []string{
+		"src/my_project/main_test.py",
+		"tests/test_main.py",
+		"tests/integration/test_pipeline.py",
+	}, Discover(files, nil))
+
+	assert.Equal(t, []string{
+		"tests/test_main.py",
+		"tests/integration/test_pipeline.py",
+	}, Discover(files, []string{"tests/"}))
+
+	assert.Equal(t, []string{
+		"src/my_project/main_test.py",
+		"tests/integration/test_pipeline.py",
+	}, Discover(files, []string{"tests/integration", "src/my_project/main_test.py"}))
+
+	assert.Len(t, Discover(files, []string{"."}), 3)
+	assert.Empty(t, Discover(files, []string{"test"}))
+}
@@ -0,0 +1,83 @@
+package testrun
+
+import (
+	"encoding/xml"
+	"fmt"
+)
+
+// Report summarizes a JUnit XML report as written by pytest.
+type Report struct {
+	Tests    int
+	Failures int
+	Errors   int
+	Skipped  int
+
+	// Failed lists the tests that failed or raised an error, as "<classname>.<name>".
+	Failed []string
+}
+
+// Passed reports whether no test failed or raised an error.
+func (r *Report) Passed() bool {
+	return r.Failures == 0 && r.Errors == 0
+}
+
+func (r *Report) String() string {
+	passed := r.Tests - r.Failures - r.Errors - r.Skipped
+	return fmt.Sprintf("%d passed, %d failed, %d errors, %d skipped", passed, r.Failures, r.Errors, r.Skipped)
+}
+
+type junitTestCase struct {
+	ClassName string    `xml:"classname,attr"`
+	Name      string    `xml:"name,attr"`
+	Failure   *struct{} `xml:"failure"`
+	Error     *struct{} `xml:"error"`
+	Skipped   *struct{} `xml:"skipped"`
+}
+
+type junitTestSuite struct {
+	TestCases []junitTestCase `xml:"testcase"`
+}
+
+type junitTestSuites struct {
+	XMLName    xml.Name
+	TestSuites []junitTestSuite `xml:"testsuite"`
+	TestCases  []junitTestCase  `xml:"testcase"`
+}
+
+// ParseReport reads a JUnit XML report. Both a <testsuites> root element (pytest 5.1 and newer)
+// and a single <testsuite> root element are supported.
+func ParseReport(data []byte) (*Report, error) {
+	var root junitTestSuites
+	err := xml.Unmarshal(data, &root)
+	if err != nil {
+		return nil, fmt.Errorf("parsing JUnit XML report: %w", err)
+	}
+
+	var suites []junitTestSuite
+	switch root.XMLName.Local {
+	case "testsuites":
+		suites = root.TestSuites
+	case "testsuite":
+		suites = []junitTestSuite{{TestCases: root.TestCases}}
+	default:
+		return nil, fmt.Errorf("parsing JUnit XML report: unexpected root element <%s>", root.XMLName.Local)
+	}
+
+	report := &Report{}
+	for _, suite := range suites {
+		for _, tc := range suite.TestCases {
+			report.Tests++
+			switch {
+			case tc.Failure != nil:
+				report.Failures++
+				report.Failed = append(report.Failed, tc.ClassName+"."+tc.Name)
+			case tc.Error != nil:
+				report.Errors++
+				report.Failed = append(report.Failed, tc.ClassName+"."+tc.Name)
+			case tc.Skipped != nil:
+				report.Skipped++
+			}
+		}
+	}
+	return report, nil
+}
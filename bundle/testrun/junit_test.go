@@ -0,0 +1,56 @@
+package testrun
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseReport(t *testing.T) {
+	data := `<?xml version="1.0" encoding="utf-8"?>
+<testsuites>
+  <testsuite name="pytest" errors="1" failures="1" skipped="1" tests="5">
+    <testcase classname="tests.test_main" name="test_ok" time="0.001"/>
+    <testcase classname="tests.test_main" name="test_ok_too" time="0.001"/>
+    <testcase classname="tests.test_main" name="test_fails" time="0.002">
+      <failure message="assert 1 == 2">def test_fails(): assert 1 == 2</failure>
+    </testcase>
+    <testcase classname="tests.test_main" name="test_errors" time="0.001">
+      <error message="failed on setup">fixture not found</error>
+    </testcase>
+    <testcase classname="tests.test_main" name="test_skipped" time="0.000">
+      <skipped type="pytest.skip" message="not now"/>
+    </testcase>
+  </testsuite>
+</testsuites>`
+
+	report, err := ParseReport([]byte(data))
+	require.NoError(t, err)
+	assert.Equal(t, &Report{
+		Tests:    5,
+		Failures: 1,
+		Errors:   1,
+		Skipped:  1,
+		Failed:   []string{"tests.test_main.test_fails", "tests.test_main.test_errors"},
+	}, report)
+	assert.False(t, report.Passed())
+	assert.Equal(t, "2 passed, 1 failed, 1 errors, 1 skipped", report.String())
+}
+
+func TestParseReportSingleSuite(t *testing.T) {
+	data := `<testsuite name="pytest" tests="1"><testcase classname="test_a" name="test_one"/></testsuite>`
+
+	report, err := ParseReport([]byte(data))
+	require.NoError(t, err)
+	assert.Equal(t, 1, report.Tests)
+	assert.True(t, report.Passed())
+}
+
+func TestParseReportInvalid(t *testing.T) {
+	_, err := ParseReport([]byte(`<html></html>`))
+	assert.EqualError(t, err, "parsing JUnit XML report: unexpected root element <html>")
+
+	_, err = ParseReport([]byte(`not xml`))
+	assert.ErrorContains(t, err, "parsing JUnit XML report")
+}
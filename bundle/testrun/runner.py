@@ -0,0 +1,63 @@
+# Runs pytest on behalf of "databricks bundle test".
+#
+# Usage: run_pytest.py <root> <junit_xml> <log> [<test file>...]
+#
+# <root> is the directory that the bundle files are synchronized to. It becomes the working
+# directory and is added to sys.path, so that tests can import the project's modules.
+#
+# The output of pytest is also appended to <log> line by line, so that the CLI can stream it
+# while the tests run.
+import os
+import sys
+
+import pytest
+
+
+class Tee:
+    """Writes to a stream and appends every complete line to a file."""
+
+    def __init__(self, stream, path):
+        self._stream = stream
+        self._path = path
+        self._pending = ""
+
+    def write(self, s):
+        self._stream.write(s)
+        self._pending += s
+        if "\n" in self._pending:
+            lines, _, self._pending = self._pending.rpartition("\n")
+            self._append(lines + "\n")
+        return len(s)
+
+    def flush(self):
+        self._stream.flush()
+        if self._pending:
+            self._append(self._pending)
+            self._pending = ""
+
+    def _append(self, s):
+        # Workspace files are only visible to readers once closed, so the file is reopened for every write.
+        with open(self._path, "a") as f:
+            f.write(s)
+
+    def __getattr__(self, name):
+        return getattr(self._stream, name)
+
+
+root, junit_xml, log, args = sys.argv[1], sys.argv[2], sys.argv[3], sys.argv[4:]
+
+open(log, "w").close()
+sys.stdout = Tee(sys.stdout, log)
+sys.stderr = Tee(sys.stderr, log)
+
+os.chdir(root)
+sys.path.insert(0, root)
+
+# The workspace file system does not support writing bytecode and pytest's cache next to the sources.
+sys.dont_write_bytecode = True
+
+code = pytest.main(["-v", "-p", "no:cacheprovider", "--junitxml", junit_xml] + args)
+sys.stdout.flush()
+sys.stderr.flush()
+if code != pytest.ExitCode.OK:
+    sys.exit(int(code))
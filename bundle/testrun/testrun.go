@@ -0,0 +1,248 @@
+// Package testrun runs the pytest tests of a bundle on Databricks compute for "bundle test".
+package testrun
+
+import (
+	"bytes"
+	"context"
+	_ "embed"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/deploy"
+	"github.com/databricks/cli/bundle/run"
+	"github.com/databricks/cli/libs/filer"
+	"github.com/databricks/cli/libs/log"
+	"github.com/databricks/databricks-sdk-go/service/compute"
+	"github.com/databricks/databricks-sdk-go/service/jobs"
+)
+
+//go:embed runner.py
+var runnerScript []byte
+
+const (
+	// Paths relative to the state path of the bundle.
+	runnerPath = "test/run_pytest.py"
+	reportPath = "test/junit.xml"
+	logPath    = "test/pytest.log"
+
+	taskKey        = "pytest"
+	environmentKey = "pytest"
+)
+
+type Options struct {
+	// Test files or directories to run, relative to the sync root. If empty, all test files are run.
+	Filters []string
+
+	// Cluster to run the tests on. If empty, the tests run on serverless compute.
+	ClusterID string
+
+	// Local path to write the JUnit XML report to.
+	JUnitXMLPath string
+
+	// Writer that the output of pytest is streamed to while the tests run.
+	Output io.Writer
+}
+
+type Result struct {
+	// Test files that were run, relative to the sync root.
+	TestFiles []string
+
+	// The completed run.
+	Run *jobs.Run
+
+	// Summary of the JUnit XML report, or nil if the run did not produce a report.
+	Report *Report
+}
+
+// Passed reports whether the run has completed successfully and all tests have passed.
+func (r *Result) Passed() bool {
+	return r.Run.State.ResultState == jobs.RunResultStateSuccess && r.Report != nil && r.Report.Passed()
+}
+
+// Run submits a one-time run that executes the test files among files with pytest and waits for it to complete,
+// streaming the output of pytest to opts.Output.
+// The files are slash-separated paths relative to the sync root and must have been uploaded to root in the workspace.
+func Run(ctx context.Context, b *bundle.Bundle, root string, files []string, opts Options) (*Result, error) {
+	testFiles := Discover(files, opts.Filters)
+	if len(testFiles) == 0 {
+		if len(opts.Filters) > 0 {
+			return nil, fmt.Errorf("no test files found in %s; test files must be named test_*.py or *_test.py", strings.Join(opts.Filters, ", "))
+		}
+		return nil, errors.New("no test files found; test files must be named test_*.py or *_test.py")
+	}
+
+	f, err := deploy.StateFiler(b)
+	if err != nil {
+		return nil, err
+	}
+
+	err = f.Write(ctx, runnerPath, bytes.NewReader(runnerScript), filer.OverwriteIfExists, filer.CreateParentDirectories)
+	if err != nil {
+		return nil, fmt.Errorf("uploading test runner: %w", err)
+	}
+
+	// Remove the report and log of an earlier run, so that they are not mistaken for those of this run.
+	for _, p := range []string{reportPath, logPath} {
+		err = f.Delete(ctx, p)
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return nil, fmt.Errorf("removing output of previous test run: %w", err)
+		}
+	}
+
+	statePath := b.Config.Workspace.StatePath
+	req := submitRequest(b.Config.Bundle.Name, opts.ClusterID, path.Join(statePath, runnerPath), driverPath(root), driverPath(path.Join(statePath, reportPath)), driverPath(path.Join(statePath, logPath)), testFiles)
+
+	streamer := &logStreamer{filer: f, path: logPath, out: opts.Output}
+	r, err := run.SubmitAndWait(ctx, b, req, func(*jobs.Run) {
+		streamer.stream(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Print the remainder of the log, or the output captured by the run if the runner did not get to write the log,
+	// e.g. because pytest could not be installed.
+	streamer.stream(ctx)
+	if streamer.offset == 0 {
+		writeRunOutput(ctx, b, r, opts.Output)
+	}
+
+	result := &Result{
+		TestFiles: testFiles,
+		Run:       r,
+	}
+
+	data, err := readReport(ctx, f)
+	if errors.Is(err, fs.ErrNotExist) {
+		return result, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("downloading test report: %w", err)
+	}
+
+	err = os.MkdirAll(filepath.Dir(opts.JUnitXMLPath), 0o755)
+	if err != nil {
+		return nil, err
+	}
+	err = os.WriteFile(opts.JUnitXMLPath, data, 0o644)
+	if err != nil {
+		return nil, err
+	}
+
+	result.Report, err = ParseReport(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func submitRequest(bundleName, clusterID, runnerPath, root, reportPath, logPath string, testFiles []string) jobs.SubmitRun {
+	task := jobs.SubmitTask{
+		TaskKey: taskKey,
+		SparkPythonTask: &jobs.SparkPythonTask{
+			PythonFile: runnerPath,
+			Parameters: append([]string{root, reportPath, logPath}, testFiles...),
+		},
+	}
+
+	req := jobs.SubmitRun{
+		RunName: fmt.Sprintf("[%s] bundle test", bundleName),
+	}
+
+	if clusterID != "" {
+		task.ExistingClusterId = clusterID
+		task.Libraries = []compute.Library{
+			{Pypi: &compute.PythonPyPiLibrary{Package: "pytest"}},
+		}
+	} else {
+		task.EnvironmentKey = environmentKey
+		req.Environments = []jobs.JobEnvironment{
+			{
+				EnvironmentKey: environmentKey,
+				Spec: &compute.Environment{
+					Client:       "2",
+					Dependencies: []string{"pytest"},
+				},
+			},
+		}
+	}
+
+	req.Tasks = []jobs.SubmitTask{task}
+	return req
+}
+
+// driverPath returns the path under which a workspace file is accessible from the driver.
+func driverPath(p string) string {
+	if p == "/Workspace" || strings.HasPrefix(p, "/Workspace/") {
+		return p
+	}
+	return path.Join("/Workspace", p)
+}
+
+func readReport(ctx context.Context, f filer.Filer) ([]byte, error) {
+	reader, err := f.Read(ctx, reportPath)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+	return io.ReadAll(reader)
+}
+
+// logStreamer writes the part of the pytest log that was appended since the previous call to out.
+type logStreamer struct {
+	filer  filer.Filer
+	path   string
+	out    io.Writer
+	offset int
+}
+
+func (s *logStreamer) stream(ctx context.Context) {
+	reader, err := s.filer.Read(ctx, s.path)
+	if err != nil {
+		// The log does not exist until the runner starts.
+		if !errors.Is(err, fs.ErrNotExist) {
+			log.Debugf(ctx, "Unable to read test log: %s", err)
+		}
+		return
+	}
+	defer reader.Close()
+
+	// Workspace files cannot be read from an offset; the log is read in full and the part that was printed is skipped.
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		log.Debugf(ctx, "Unable to read test log: %s", err)
+		return
+	}
+	if len(data) <= s.offset {
+		return
+	}
+	_, _ = s.out.Write(data[s.offset:])
+	s.offset = len(data)
+}
+
+// writeRunOutput writes the output captured by the tasks of the run to out.
+func writeRunOutput(ctx context.Context, b *bundle.Bundle, r *jobs.Run, out io.Writer) {
+	w := b.WorkspaceClient()
+	for _, task := range r.Tasks {
+		output, err := w.Jobs.GetRunOutput(ctx, jobs.GetRunOutputRequest{RunId: task.RunId})
+		if err != nil {
+			log.Warnf(ctx, "Unable to fetch output of task %s: %s", task.TaskKey, err)
+			continue
+		}
+		if output.Logs == "" {
+			continue
+		}
+		fmt.Fprint(out, output.Logs)
+		if !strings.HasSuffix(output.Logs, "\n") {
+			fmt.Fprintln(out)
+		}
+	}
+}
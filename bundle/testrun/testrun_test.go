@@ -0,0 +1,71 @@
+package testrun
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/databricks/cli/libs/filer"
+	"github.com/databricks/databricks-sdk-go/service/compute"
+	"github.com/databricks/databricks-sdk-go/service/jobs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubmitRequestServerless(t *testing.T) {
+	req := submitRequest("my_bundle", "", "/Workspace/state/test/run_pytest.py", "/Workspace/files", "/Workspace/state/test/junit.xml", "/Workspace/state/test/pytest.log", []string{"tests/test_a.py"})
+
+	assert.Equal(t, "[my_bundle] bundle test", req.RunName)
+	require.Len(t, req.Tasks, 1)
+	task := req.Tasks[0]
+	assert.Equal(t, &jobs.SparkPythonTask{
+		PythonFile: "/Workspace/state/test/run_pytest.py",
+		Parameters: []string{"/Workspace/files", "/Workspace/state/test/junit.xml", "/Workspace/state/test/pytest.log", "tests/test_a.py"},
+	}, task.SparkPythonTask)
+	assert.Empty(t, task.ExistingClusterId)
+	assert.Equal(t, "pytest", task.EnvironmentKey)
+	require.Len(t, req.Environments, 1)
+	assert.Equal(t, []string{"pytest"}, req.Environments[0].Spec.Dependencies)
+}
+
+func TestSubmitRequestCluster(t *testing.T) {
+	req := submitRequest("my_bundle", "0123-456789-abcdef", "/runner.py", "/root", "/junit.xml", "/pytest.log", []string{"test_a.py"})
+
+	require.Len(t, req.Tasks, 1)
+	task := req.Tasks[0]
+	assert.Equal(t, "0123-456789-abcdef", task.ExistingClusterId)
+	assert.Equal(t, []compute.Library{{Pypi: &compute.PythonPyPiLibrary{Package: "pytest"}}}, task.Libraries)
+	assert.Empty(t, task.EnvironmentKey)
+	assert.Empty(t, req.Environments)
+}
+
+func TestDriverPath(t *testing.T) {
+	assert.Equal(t, "/Workspace/Users/foo/.bundle", driverPath("/Workspace/Users/foo/.bundle"))
+	assert.Equal(t, "/Workspace/Users/foo/.bundle", driverPath("/Users/foo/.bundle"))
+	assert.Equal(t, "/Workspace/WorkspaceX", driverPath("/WorkspaceX"))
+}
+
+func TestLogStreamer(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	f, err := filer.NewLocalClient(dir)
+	require.NoError(t, err)
+
+	var out bytes.Buffer
+	s := &logStreamer{filer: f, path: "pytest.log", out: &out}
+
+	// The log does not exist until the runner starts.
+	s.stream(ctx)
+	assert.Empty(t, out.String())
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "pytest.log"), []byte("collected 2 items\n"), 0o644))
+	s.stream(ctx)
+	assert.Equal(t, "collected 2 items\n", out.String())
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "pytest.log"), []byte("collected 2 items\ntest_a.py::test_a PASSED\n"), 0o644))
+	s.stream(ctx)
+	s.stream(ctx)
+	assert.Equal(t, "collected 2 items\ntest_a.py::test_a PASSED\n", out.String())
+}
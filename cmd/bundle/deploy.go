@@ -4,10 +4,12 @@ package bundle
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"github.com/databricks/cli/bundle"
 	"github.com/databricks/cli/bundle/config/validate"
+	"github.com/databricks/cli/bundle/deployplan"
 	"github.com/databricks/cli/bundle/phases"
 	"github.com/databricks/cli/cmd/bundle/utils"
 	"github.com/databricks/cli/cmd/root"
@@ -27,6 +29,7 @@ Common patterns:
   databricks bundle deploy                  # Deploy to default target (dev)
   databricks bundle deploy --target dev     # Deploy to development
   databricks bundle deploy --target prod    # Deploy to production
+  databricks bundle deploy --only jobs.foo  # Deploy only one job and the resources it references
 
 See https://docs.databricks.com/en/dev-tools/bundles/index.html for more information.`,
 		Args: root.NoArgs,
@@ -38,6 +41,8 @@ See https://docs.databricks.com/en/dev-tools/bundles/index.html for more informa
 	var clusterId string
 	var autoApprove bool
 	var verbose bool
+	var planPath string
+	var only []string
 	cmd.Flags().BoolVar(&force, "force", false, "Force-override Git branch validation.")
 	cmd.Flags().BoolVar(&forceLock, "force-lock", false, "Force acquisition of deployment lock.")
 	cmd.Flags().BoolVar(&failOnActiveRuns, "fail-on-active-runs", false, "Fail if there are running jobs or pipelines in the deployment.")
@@ -45,6 +50,8 @@ See https://docs.databricks.com/en/dev-tools/bundles/index.html for more informa
 	cmd.Flags().StringVarP(&clusterId, "cluster-id", "c", "", "Override cluster in the deployment with the given cluster ID.")
 	cmd.Flags().BoolVar(&autoApprove, "auto-approve", false, "Skip interactive approvals that might be required for deployment.")
 	cmd.Flags().MarkDeprecated("compute-id", "use --cluster-id instead")
+	cmd.Flags().StringVar(&planPath, "plan", "", "Apply a plan saved with \"bundle plan --out\" instead of calculating a new one (direct deployment engine only).")
+	cmd.Flags().StringSliceVar(&only, "only", nil, "Deploy only the given resources and the resources they reference, e.g. jobs.foo,pipelines.bar or jobs.etl_* (direct deployment engine only).")
 	cmd.Flags().BoolVar(&verbose, "verbose", false, "Enable verbose output.")
 	// Verbose flag currently only affects file sync output, it's used by the vscode extension
 	cmd.Flags().MarkHidden("verbose")
@@ -93,6 +100,27 @@ See https://docs.databricks.com/en/dev-tools/bundles/index.html for more informa
 			return root.ErrAlreadyPrinted
 		}
 
+		if planPath != "" {
+			if !b.DirectDeployment {
+				return errors.New("--plan is only supported by the direct deployment engine")
+			}
+			plan, err := deployplan.LoadPlan(planPath)
+			if err != nil {
+				return err
+			}
+			b.SavedPlan = plan
+		}
+
+		if len(only) > 0 {
+			if !b.DirectDeployment {
+				return errors.New("--only is only supported by the direct deployment engine")
+			}
+			if planPath != "" {
+				return errors.New("--only cannot be used together with --plan")
+			}
+			b.OnlyResources = only
+		}
+
 		t1 := time.Now()
 		bundle.ApplyContext(ctx, b, validate.FastValidate())
 		b.Metrics.ExecutionTimes = append(b.Metrics.ExecutionTimes, protos.IntMapEntry{
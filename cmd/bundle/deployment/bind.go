@@ -2,16 +2,23 @@ package deployment
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"os"
+	"strings"
 
 	"github.com/databricks/cli/bundle"
 	"github.com/databricks/cli/bundle/deploy/terraform"
+	"github.com/databricks/cli/bundle/deployplan"
 	"github.com/databricks/cli/bundle/phases"
+	"github.com/databricks/cli/bundle/terranova"
 	"github.com/databricks/cli/cmd/bundle/utils"
 	"github.com/databricks/cli/cmd/root"
 	"github.com/databricks/cli/libs/cmdio"
 	"github.com/databricks/cli/libs/logdiag"
+	libsutils "github.com/databricks/cli/libs/utils"
 	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
 )
 
 func newBindCommand() *cobra.Command {
@@ -37,6 +44,13 @@ Examples:
   # Bind with automatic approval (useful for CI/CD)
   databricks bundle deployment bind my_job 123 --auto-approve
 
+  # Bind all resources listed in a mapping file (direct deployment engine only)
+  databricks bundle deployment bind --file bindings.yml
+
+The mapping file maps "<group>.<key>" of bundle resources to the IDs of existing resources:
+  jobs.my_etl_job: 6565621249
+  jobs.my_other_job: 1234567890
+
 Common workflow:
 1. First, generate bundle configuration from an existing resource:
    databricks bundle generate job --existing-job-id 6565621249 --key my_etl_job
@@ -49,13 +63,20 @@ Common workflow:
 
 WARNING: After binding, the workspace resource will be managed by your bundle.
 Any manual changes made in the workspace UI may be overwritten on deployment.`,
-		Args: root.ExactArgs(2),
+		Args: func(cmd *cobra.Command, args []string) error {
+			if cmd.Flag("file").Changed {
+				return root.NoArgs(cmd, args)
+			}
+			return root.ExactArgs(2)(cmd, args)
+		},
 	}
 
 	var autoApprove bool
 	var forceLock bool
+	var file string
 	cmd.Flags().BoolVar(&autoApprove, "auto-approve", false, "Automatically approve the binding")
 	cmd.Flags().BoolVar(&forceLock, "force-lock", false, "Force acquisition of deployment lock.")
+	cmd.Flags().StringVar(&file, "file", "", "Bind all resources listed in a mapping file of \"<group>.<key>: <id>\" entries (direct deployment engine only).")
 
 	cmd.RunE = func(cmd *cobra.Command, args []string) error {
 		ctx := logdiag.InitContext(cmd.Context())
@@ -71,6 +92,29 @@ Any manual changes made in the workspace UI may be overwritten on deployment.`,
 			return root.ErrAlreadyPrinted
 		}
 
+		bundle.ApplyFuncContext(ctx, b, func(context.Context, *bundle.Bundle) {
+			b.Config.Bundle.Deployment.Lock.Force = forceLock
+		})
+
+		if file != "" {
+			if !b.DirectDeployment {
+				return errors.New("--file is only supported by the direct deployment engine")
+			}
+
+			bindings, err := readBindings(file)
+			if err != nil {
+				return err
+			}
+
+			phases.BindDirect(ctx, b, bindings, autoApprove)
+			if logdiag.HasError(ctx) {
+				return root.ErrAlreadyPrinted
+			}
+
+			cmdio.LogString(ctx, fmt.Sprintf("Successfully bound %d resources. Run 'bundle deploy' to deploy changes to your workspace", len(bindings)))
+			return nil
+		}
+
 		resource, err := b.Config.Resources.FindResourceByConfigKey(args[0])
 		if err != nil {
 			return err
@@ -86,17 +130,20 @@ Any manual changes made in the workspace UI may be overwritten on deployment.`,
 			return fmt.Errorf("%s with an id '%s' is not found", resource.ResourceDescription().SingularName, args[1])
 		}
 
-		bundle.ApplyFuncContext(ctx, b, func(context.Context, *bundle.Bundle) {
-			b.Config.Bundle.Deployment.Lock.Force = forceLock
-		})
-
-		tfName := terraform.GroupToTerraformName[resource.ResourceDescription().PluralName]
-		phases.Bind(ctx, b, &terraform.BindOptions{
-			AutoApprove:  autoApprove,
-			ResourceType: tfName,
-			ResourceKey:  args[0],
-			ResourceId:   args[1],
-		})
+		if b.DirectDeployment {
+			phases.BindDirect(ctx, b, []terranova.Binding{{
+				ResourceNode: deployplan.ResourceNode{Group: resource.ResourceDescription().PluralName, Key: args[0]},
+				ID:           args[1],
+			}}, autoApprove)
+		} else {
+			tfName := terraform.GroupToTerraformName[resource.ResourceDescription().PluralName]
+			phases.Bind(ctx, b, &terraform.BindOptions{
+				AutoApprove:  autoApprove,
+				ResourceType: tfName,
+				ResourceKey:  args[0],
+				ResourceId:   args[1],
+			})
+		}
 		if logdiag.HasError(ctx) {
 			return root.ErrAlreadyPrinted
 		}
@@ -107,3 +154,38 @@ Any manual changes made in the workspace UI may be overwritten on deployment.`,
 
 	return cmd
 }
+
+// readBindings reads a mapping file of "<group>.<key>: <id>" entries.
+func readBindings(path string) ([]terranova.Binding, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read bindings: %w", err)
+	}
+
+	var mapping map[string]string
+	err = yaml.Unmarshal(data, &mapping)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse bindings in %s: %w", path, err)
+	}
+
+	if len(mapping) == 0 {
+		return nil, fmt.Errorf("no bindings found in %s", path)
+	}
+
+	bindings := make([]terranova.Binding, 0, len(mapping))
+	for _, name := range libsutils.SortedKeys(mapping) {
+		group, key, ok := strings.Cut(name, ".")
+		if !ok || group == "" || key == "" {
+			return nil, fmt.Errorf("invalid binding %q in %s: expected \"<group>.<key>\", for example \"jobs.my_job\"", name, path)
+		}
+		if mapping[name] == "" {
+			return nil, fmt.Errorf("invalid binding %q in %s: resource ID is empty", name, path)
+		}
+		bindings = append(bindings, terranova.Binding{
+			ResourceNode: deployplan.ResourceNode{Group: group, Key: key},
+			ID:           mapping[name],
+		})
+	}
+
+	return bindings, nil
+}
@@ -0,0 +1,85 @@
+package deployment
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/phases"
+	"github.com/databricks/cli/cmd/bundle/utils"
+	"github.com/databricks/cli/cmd/root"
+	"github.com/databricks/cli/libs/cmdio"
+	"github.com/databricks/cli/libs/logdiag"
+	"github.com/spf13/cobra"
+)
+
+func newMigrateCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate",
+		Short: "Migrate the deployment state from Terraform to the direct deployment engine",
+		Long: `Migrate the deployment state of a bundle from Terraform to the direct deployment engine.
+
+This command converts the Terraform state of a deployed bundle into the state used by the
+direct deployment engine (DATABRICKS_CLI_DEPLOYMENT=direct-exp) and uploads it to the workspace.
+Every resource in the Terraform state is checked to still exist in the workspace.
+
+After the migration, deploy with DATABRICKS_CLI_DEPLOYMENT=direct-exp. If the bundle has not changed
+since the last deployment, the first deployment with the direct engine does not change any resources.
+
+The Terraform state is not modified.
+
+Examples:
+  # Migrate the default target
+  databricks bundle deployment migrate
+
+  # Check that there is nothing to deploy after the migration
+  DATABRICKS_CLI_DEPLOYMENT=direct-exp databricks bundle plan`,
+		Args: root.NoArgs,
+
+		// The direct deployment engine is experimental.
+		Hidden: true,
+	}
+
+	var forceLock bool
+	cmd.Flags().BoolVar(&forceLock, "force-lock", false, "Force acquisition of deployment lock.")
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		ctx := logdiag.InitContext(cmd.Context())
+		cmd.SetContext(ctx)
+
+		b := utils.ConfigureBundleWithVariables(cmd)
+		if b == nil || logdiag.HasError(ctx) {
+			return root.ErrAlreadyPrinted
+		}
+
+		phases.Initialize(ctx, b)
+		if logdiag.HasError(ctx) {
+			return root.ErrAlreadyPrinted
+		}
+
+		bundle.ApplyFuncContext(ctx, b, func(context.Context, *bundle.Bundle) {
+			b.Config.Bundle.Deployment.Lock.Force = forceLock
+		})
+
+		// The saved state must match the configuration that deploy applies, which includes built artifacts.
+		phases.Build(ctx, b)
+		if logdiag.HasError(ctx) {
+			return root.ErrAlreadyPrinted
+		}
+
+		phases.Migrate(ctx, b)
+		if logdiag.HasError(ctx) {
+			return root.ErrAlreadyPrinted
+		}
+
+		count := 0
+		for _, group := range b.ResourceDatabase.Data.Resources {
+			count += len(group)
+		}
+
+		cmdio.LogString(ctx, fmt.Sprintf("Successfully migrated %d resources to the direct deployment engine. Set DATABRICKS_CLI_DEPLOYMENT=direct-exp to deploy with it", count))
+		return nil
+	}
+
+	return cmd
+}
@@ -0,0 +1,94 @@
+package bundle
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/databricks/cli/bundle/deployplan"
+	"github.com/databricks/cli/bundle/phases"
+	"github.com/databricks/cli/bundle/render"
+	"github.com/databricks/cli/cmd/bundle/utils"
+	"github.com/databricks/cli/cmd/root"
+	"github.com/databricks/cli/libs/flags"
+	"github.com/databricks/cli/libs/logdiag"
+	"github.com/spf13/cobra"
+)
+
+func newDriftCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "drift",
+		Short: "Show resources that were changed outside of the bundle",
+		Long: `Show resources that were changed outside of the bundle.
+
+Reads the settings of every deployed resource from the workspace and compares them
+with the settings of the last deployment. Fields that differ are reported per resource,
+as well as resources that no longer exist.
+
+Only supported by the direct deployment engine.`,
+		Args: root.NoArgs,
+
+		// Output format may change without notice, same as "bundle plan".
+		Hidden: true,
+	}
+
+	var failOnDrift bool
+	cmd.Flags().BoolVar(&failOnDrift, "fail-on-drift", false, "Exit with a non-zero exit code if drift is detected.")
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		ctx := logdiag.InitContext(cmd.Context())
+		cmd.SetContext(ctx)
+
+		b := utils.ConfigureBundleWithVariables(cmd)
+		if b == nil || logdiag.HasError(ctx) {
+			return root.ErrAlreadyPrinted
+		}
+
+		phases.Initialize(ctx, b)
+		if logdiag.HasError(ctx) {
+			return root.ErrAlreadyPrinted
+		}
+
+		drifts := phases.Drift(ctx, b)
+		if logdiag.HasError(ctx) {
+			return root.ErrAlreadyPrinted
+		}
+
+		var err error
+		switch root.OutputType(cmd) {
+		case flags.OutputText:
+			err = render.RenderDrift(cmd.OutOrStdout(), drifts)
+		case flags.OutputJSON:
+			err = renderDriftJSON(cmd.OutOrStdout(), drifts)
+		default:
+			err = fmt.Errorf("unknown output type %s", root.OutputType(cmd))
+		}
+		if err != nil {
+			return err
+		}
+
+		if failOnDrift && len(drifts) > 0 {
+			return fmt.Errorf("drift detected in %d resource(s)", len(drifts))
+		}
+
+		return nil
+	}
+
+	return cmd
+}
+
+type driftOutput struct {
+	Drift []deployplan.Drift `json:"drift"`
+}
+
+func renderDriftJSON(out io.Writer, drifts []deployplan.Drift) error {
+	if drifts == nil {
+		drifts = []deployplan.Drift{}
+	}
+	buf, err := json.MarshalIndent(driftOutput{Drift: drifts}, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = out.Write(append(buf, '\n'))
+	return err
+}
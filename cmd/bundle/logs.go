@@ -0,0 +1,184 @@
+package bundle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/databricks/cli/bundle"
+	configresources "github.com/databricks/cli/bundle/config/resources"
+	"github.com/databricks/cli/bundle/logs"
+	"github.com/databricks/cli/bundle/phases"
+	"github.com/databricks/cli/bundle/resources"
+	"github.com/databricks/cli/bundle/statemgmt"
+	"github.com/databricks/cli/cmd/bundle/utils"
+	"github.com/databricks/cli/cmd/root"
+	"github.com/databricks/cli/libs/cmdio"
+	"github.com/databricks/cli/libs/logdiag"
+	"github.com/spf13/cobra"
+	"golang.org/x/exp/maps"
+)
+
+// hasLogs returns true for the resources that bundle logs can retrieve the output of.
+func hasLogs(ref resources.Reference) bool {
+	switch ref.Resource.(type) {
+	case *configresources.Job, *configresources.Pipeline:
+		return true
+	default:
+		return false
+	}
+}
+
+func promptLogsArgument(ctx context.Context, b *bundle.Bundle) (string, error) {
+	// Compute map of "Human readable name of resource" -> "resource key".
+	inv := make(map[string]string)
+	for k, ref := range resources.Completions(b, hasLogs) {
+		title := fmt.Sprintf("%s: %s", ref.Description.SingularTitle, ref.Resource.GetName())
+		inv[title] = k
+	}
+
+	key, err := cmdio.Select(ctx, inv, "Resource to show the logs of")
+	if err != nil {
+		return "", err
+	}
+
+	return key, nil
+}
+
+func resolveLogsArgument(ctx context.Context, b *bundle.Bundle, args []string) (string, error) {
+	// If no arguments are specified, prompt the user to select the resource.
+	if len(args) == 0 && cmdio.IsPromptSupported(ctx) {
+		return promptLogsArgument(ctx, b)
+	}
+
+	if len(args) < 1 {
+		return "", errors.New("expected a KEY of the job or pipeline to show the logs of")
+	}
+
+	return args[0], nil
+}
+
+const jobRunsTemplate = `{{range .}}Run {{.RunId}} started at {{.StartTime}}: {{.State}}{{if .StateMessage}} ({{.StateMessage}}){{end}}
+{{.RunPageUrl}}
+{{range .Tasks}}
+=== Task {{.TaskKey}}: {{.State}}
+{{if .Output}}{{.Output}}
+{{end}}{{if .Error}}Error: {{.Error}}
+{{end}}{{if .ErrorTrace}}{{.ErrorTrace}}
+{{end}}{{end}}
+{{end}}`
+
+const pipelineUpdatesTemplate = `{{range .}}Update {{.UpdateId}} created at {{.CreationTime}}: {{.State}}
+{{range .Events}}{{.Timestamp}} {{.Level}} {{.EventType}} {{.Message}}
+{{if .Error}}{{range .Error.Exceptions}}  {{.ClassName}}: {{.Message}}
+{{end}}{{end}}{{end}}
+{{end}}`
+
+func newLogsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "logs [flags] [KEY]",
+		Short: "Show the output of past runs of a job or pipeline",
+		Long: `Show the output of past runs of the job or pipeline identified by KEY.
+
+For jobs, the output and error trace of every task of the run are shown.
+For pipelines, the event log of the update is shown.
+
+By default, the most recent completed run or update is shown.
+
+Examples:
+  databricks bundle logs my_job                  # Most recent run of a job
+  databricks bundle logs my_job --failed         # Most recent failed run of a job
+  databricks bundle logs my_job --last 3         # Three most recent runs of a job
+  databricks bundle logs my_job --run-id 1234    # Specific run of a job
+  databricks bundle logs my_pipeline             # Most recent update of a pipeline`,
+		Args: root.MaximumNArgs(1),
+	}
+
+	var opts logs.Options
+	cmd.Flags().StringVar(&opts.RunId, "run-id", "", "ID of the job run or pipeline update to show.")
+	cmd.Flags().IntVar(&opts.Last, "last", 1, "Number of most recent runs to show.")
+	cmd.Flags().BoolVar(&opts.Failed, "failed", false, "Only show failed runs.")
+	cmd.MarkFlagsMutuallyExclusive("run-id", "last")
+	cmd.MarkFlagsMutuallyExclusive("run-id", "failed")
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		ctx := logdiag.InitContext(cmd.Context())
+		cmd.SetContext(ctx)
+
+		b := utils.ConfigureBundleWithVariables(cmd)
+		if b == nil || logdiag.HasError(ctx) {
+			return root.ErrAlreadyPrinted
+		}
+
+		phases.Initialize(ctx, b)
+		if logdiag.HasError(ctx) {
+			return root.ErrAlreadyPrinted
+		}
+
+		key, err := resolveLogsArgument(ctx, b, args)
+		if err != nil {
+			return err
+		}
+
+		// Load the deployment state to get the IDs of the resources.
+		bundle.ApplySeqContext(ctx, b,
+			statemgmt.StatePull(),
+			statemgmt.Load(statemgmt.ErrorOnEmptyState),
+		)
+		if logdiag.HasError(ctx) {
+			return root.ErrAlreadyPrinted
+		}
+
+		ref, err := resources.Lookup(b, key, hasLogs)
+		if err != nil {
+			return err
+		}
+
+		w := b.WorkspaceClient()
+		switch resource := ref.Resource.(type) {
+		case *configresources.Job:
+			jobId, err := strconv.ParseInt(resource.ID, 10, 64)
+			if err != nil {
+				return fmt.Errorf("job %s has not been deployed", ref.Key)
+			}
+			runs, err := logs.JobRuns(ctx, w, jobId, opts)
+			if err != nil {
+				return err
+			}
+			return cmdio.RenderWithTemplate(ctx, runs, "", jobRunsTemplate)
+
+		case *configresources.Pipeline:
+			if resource.ID == "" {
+				return fmt.Errorf("pipeline %s has not been deployed", ref.Key)
+			}
+			updates, err := logs.PipelineUpdates(ctx, w, resource.ID, opts)
+			if err != nil {
+				return err
+			}
+			return cmdio.RenderWithTemplate(ctx, updates, "", pipelineUpdatesTemplate)
+		}
+
+		return fmt.Errorf("showing logs is not supported for %s", ref.KeyWithType)
+	}
+
+	cmd.ValidArgsFunction = func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		ctx := logdiag.InitContext(cmd.Context())
+		cmd.SetContext(ctx)
+
+		b := root.MustConfigureBundle(cmd)
+		if logdiag.HasError(cmd.Context()) {
+			return nil, cobra.ShellCompDirectiveError
+		}
+
+		// No completion in the context of a bundle.
+		if b == nil || len(args) > 0 {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+
+		completions := resources.Completions(b, hasLogs)
+		return maps.Keys(completions), cobra.ShellCompDirectiveNoFileComp
+	}
+
+	return cmd
+}
@@ -2,14 +2,18 @@ package bundle
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"io"
 
 	"github.com/databricks/cli/bundle"
 	"github.com/databricks/cli/bundle/config/validate"
+	"github.com/databricks/cli/bundle/deployplan"
 	"github.com/databricks/cli/bundle/phases"
+	"github.com/databricks/cli/bundle/render"
 	"github.com/databricks/cli/cmd/bundle/utils"
 	"github.com/databricks/cli/cmd/root"
-	"github.com/databricks/cli/libs/cmdio"
+	"github.com/databricks/cli/libs/flags"
 	"github.com/databricks/cli/libs/logdiag"
 	"github.com/spf13/cobra"
 )
@@ -28,10 +32,12 @@ func newPlanCommand() *cobra.Command {
 
 	var force bool
 	var clusterId string
+	var out string
 	cmd.Flags().BoolVar(&force, "force", false, "Force-override Git branch validation.")
 	cmd.Flags().StringVar(&clusterId, "compute-id", "", "Override cluster in the deployment with the given compute ID.")
 	cmd.Flags().StringVarP(&clusterId, "cluster-id", "c", "", "Override cluster in the deployment with the given cluster ID.")
 	cmd.Flags().MarkDeprecated("compute-id", "use --cluster-id instead")
+	cmd.Flags().StringVar(&out, "out", "", "Save the plan to this file, so it can be applied with \"bundle deploy --plan\" (direct deployment engine only).")
 
 	cmd.RunE = func(cmd *cobra.Command, args []string) error {
 		ctx := logdiag.InitContext(cmd.Context())
@@ -72,18 +78,44 @@ func newPlanCommand() *cobra.Command {
 			return root.ErrAlreadyPrinted
 		}
 
-		changes := phases.Diff(ctx, b)
-
-		for _, change := range changes {
-			cmdio.LogString(ctx, fmt.Sprintf("%s %s.%s", change.ActionType, change.Group, change.Key))
-		}
+		actions := phases.Diff(ctx, b)
 
 		if logdiag.HasError(ctx) {
 			return root.ErrAlreadyPrinted
 		}
 
-		return nil
+		if out != "" {
+			phases.SavePlan(ctx, b, out)
+			if logdiag.HasError(ctx) {
+				return root.ErrAlreadyPrinted
+			}
+		}
+
+		switch root.OutputType(cmd) {
+		case flags.OutputText:
+			return render.RenderPlan(cmd.OutOrStdout(), actions)
+		case flags.OutputJSON:
+			return renderPlanJSON(cmd.OutOrStdout(), actions)
+		default:
+			return fmt.Errorf("unknown output type %s", root.OutputType(cmd))
+		}
 	}
 
 	return cmd
 }
+
+type planOutput struct {
+	Actions []deployplan.Action `json:"actions"`
+}
+
+func renderPlanJSON(out io.Writer, actions []deployplan.Action) error {
+	if actions == nil {
+		actions = []deployplan.Action{}
+	}
+	buf, err := json.MarshalIndent(planOutput{Actions: actions}, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = out.Write(append(buf, '\n'))
+	return err
+}
@@ -89,6 +89,9 @@ func newRunCommand() *cobra.Command {
 		Short: "Run a job, pipeline update or app",
 		Long: `Run the job, pipeline or app identified by KEY.
 
+Other resources can be run as well: a quality monitor is refreshed, a
+dashboard is published and a SQL warehouse is started.
+
 The KEY is the unique identifier of the resource to run. In addition to
 customizing the run using any of the available flags, you can also specify
 keyword or positional arguments as shown in these examples:
@@ -106,6 +109,19 @@ parameter names.
 If the specified job does not use job parameters and the job has a Python file
 task or a Python wheel task, the second example applies.
 
+To rerun the failed and skipped tasks of the most recent run of a job instead
+of starting a new run, use --repair. To repair an earlier run, pass its ID:
+
+   databricks bundle run my_job --repair=RUN_ID
+
+Parameters specified with the flags or arguments above are used for the
+repaired tasks.
+
+Use --follow-logs to stream the output of running tasks, prefixed with the
+task key. Tasks on clusters that deliver their logs to DBFS or a volume show
+their driver logs as they are delivered. For other tasks, such as serverless
+tasks, the output of the task run is shown where the task type supports it.
+
 ---------------------------------------------------------
 
 You can also use the bundle run command to execute scripts / commands in the same
@@ -147,6 +163,16 @@ Example usage:
 			return executeInline(cmd, args, b)
 		}
 
+		// Arguments after "--" are passed to the job as they are.
+		positionalArgs := args
+		if n := cmd.ArgsLenAtDash(); n >= 0 {
+			positionalArgs = args[:n]
+		}
+		err := runOptions.Job.ValidateRepairArgs(positionalArgs)
+		if err != nil {
+			return err
+		}
+
 		phases.Initialize(ctx, b)
 		if logdiag.HasError(ctx) {
 			return root.ErrAlreadyPrinted
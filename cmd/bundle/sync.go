@@ -19,11 +19,12 @@ import (
 )
 
 type syncFlags struct {
-	interval time.Duration
-	full     bool
-	watch    bool
-	output   flags.Output
-	dryRun   bool
+	interval  time.Duration
+	full      bool
+	watch     bool
+	watchMode sync.WatchMode
+	output    flags.Output
+	dryRun    bool
 }
 
 func (f *syncFlags) syncOptionsFromBundle(cmd *cobra.Command, b *bundle.Bundle) (*sync.SyncOptions, error) {
@@ -49,6 +50,7 @@ func (f *syncFlags) syncOptionsFromBundle(cmd *cobra.Command, b *bundle.Bundle)
 
 	opts.Full = f.full
 	opts.PollInterval = f.interval
+	opts.WatchMode = f.watchMode
 	opts.DryRun = f.dryRun
 	return opts, nil
 }
@@ -70,10 +72,13 @@ Use 'databricks bundle deploy' for full resource deployment.`,
 		Args: root.NoArgs,
 	}
 
-	var f syncFlags
-	cmd.Flags().DurationVar(&f.interval, "interval", 1*time.Second, "file system polling interval (for --watch)")
+	f := syncFlags{
+		watchMode: sync.WatchModeNotify,
+	}
+	cmd.Flags().DurationVar(&f.interval, "interval", 1*time.Second, "file system polling interval (for --watch in poll mode)")
 	cmd.Flags().BoolVar(&f.full, "full", false, "perform full synchronization (default is incremental)")
 	cmd.Flags().BoolVar(&f.watch, "watch", false, "watch local file system for changes")
+	cmd.Flags().Var(&f.watchMode, "watch-mode", "how to detect changes for --watch: notify (file system notifications, falls back to poll if unavailable) or poll")
 	cmd.Flags().Var(&f.output, "output", "type of the output format")
 	cmd.Flags().BoolVar(&f.dryRun, "dry-run", false, "simulate sync execution without making actual changes")
 
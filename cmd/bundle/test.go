@@ -1,30 +1,142 @@
 package bundle
 
 import (
+	"context"
 	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
 
+	"github.com/databricks/cli/bundle"
+	"github.com/databricks/cli/bundle/config"
+	"github.com/databricks/cli/bundle/config/validate"
+	"github.com/databricks/cli/bundle/phases"
+	"github.com/databricks/cli/bundle/testrun"
+	"github.com/databricks/cli/cmd/bundle/utils"
+	"github.com/databricks/cli/cmd/root"
+	"github.com/databricks/cli/libs/cmdio"
+	"github.com/databricks/cli/libs/logdiag"
+	"github.com/databricks/databricks-sdk-go/service/jobs"
 	"github.com/spf13/cobra"
 )
 
 func newTestCommand() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "test",
-		Short: "run tests for the project",
-		Long:  `This is longer description of the command`,
+		Use:   "test [PATH...]",
+		Short: "Run the tests of the project on Databricks",
+		Long: `Run the tests of the project on Databricks.
 
-		// We're not ready to expose this command until we specify its semantics.
-		Hidden: true,
+Uploads the bundle files to the workspace and runs the pytest tests among them
+as a one-time job run, on the cluster of the target or on serverless compute.
+Resources are not deployed. Test files are files named test_*.py or *_test.py.
+
+The output of pytest is streamed while the tests run, and the results are
+written to a JUnit XML report.
+
+Arguments:
+  PATH - Test files or directories to run, relative to the bundle root.
+         All test files are run if no paths are given.
+
+Examples:
+  databricks bundle test
+  databricks bundle test tests/unit --cluster-id 0123-456789-abcdef
+  databricks bundle test --junit-xml test-results.xml`,
 	}
 
+	var clusterID string
+	var junitXMLPath string
+	cmd.Flags().StringVarP(&clusterID, "cluster-id", "c", "", "Run the tests on the given cluster instead of the cluster of the target.")
+	cmd.Flags().StringVar(&junitXMLPath, "junit-xml", "", "Write the JUnit XML report to the given path (default: .databricks/bundle/<target>/test-results.xml).")
+
 	cmd.RunE = func(cmd *cobra.Command, args []string) error {
-		return errors.New("TODO")
-		// results := project.RunPythonOnDev(cmd.Context(), `return 1`)
-		// if results.Failed() {
-		// 	return results.Err()
-		// }
-		// fmt.Fprintf(cmd.OutOrStdout(), "Success: %s", results.Text())
-		// return nil
+		ctx := logdiag.InitContext(cmd.Context())
+		cmd.SetContext(ctx)
+
+		b := utils.ConfigureBundleWithVariables(cmd)
+		if b == nil || logdiag.HasError(ctx) {
+			return root.ErrAlreadyPrinted
+		}
+
+		if cmd.Flag("cluster-id").Changed {
+			bundle.ApplyFuncContext(ctx, b, func(ctx context.Context, b *bundle.Bundle) {
+				b.Config.Bundle.ClusterId = clusterID
+			})
+		}
+
+		phases.Initialize(ctx, b)
+		if logdiag.HasError(ctx) {
+			return root.ErrAlreadyPrinted
+		}
+
+		if b.Config.Bundle.Mode == config.Production {
+			return fmt.Errorf("cannot run tests against target %q because it is in production mode", b.Config.Bundle.Target)
+		}
+
+		filters, err := testFilters(b, args)
+		if err != nil {
+			return err
+		}
+
+		if junitXMLPath == "" {
+			dir, err := b.LocalStateDir(ctx)
+			if err != nil {
+				return err
+			}
+			junitXMLPath = filepath.Join(dir, "test-results.xml")
+		}
+
+		bundle.ApplyContext(ctx, b, validate.FastValidate())
+		if logdiag.HasError(ctx) {
+			return root.ErrAlreadyPrinted
+		}
+
+		phases.Build(ctx, b)
+		if logdiag.HasError(ctx) {
+			return root.ErrAlreadyPrinted
+		}
+
+		out := cmd.OutOrStdout()
+		result := phases.Test(ctx, b, testrun.Options{
+			Filters:      filters,
+			ClusterID:    b.Config.Bundle.ClusterId,
+			JUnitXMLPath: junitXMLPath,
+			Output:       out,
+		})
+		if logdiag.HasError(ctx) {
+			return root.ErrAlreadyPrinted
+		}
+
+		if result.Report == nil {
+			if state := result.Run.State; state.ResultState != jobs.RunResultStateSuccess {
+				return fmt.Errorf("the test run did not produce a report (result: %s)", state.ResultState)
+			}
+			return errors.New("the test run did not produce a report")
+		}
+
+		cmdio.LogString(ctx, "JUnit XML report written to "+junitXMLPath)
+		fmt.Fprintf(out, "Tests: %s\n", result.Report)
+		for _, name := range result.Report.Failed {
+			fmt.Fprintf(out, "  FAILED %s\n", name)
+		}
+
+		if !result.Passed() {
+			return errors.New("tests failed")
+		}
+		return nil
 	}
 
 	return cmd
 }
+
+// testFilters converts paths relative to the bundle root to paths relative to the sync root.
+func testFilters(b *bundle.Bundle, args []string) ([]string, error) {
+	var filters []string
+	for _, arg := range args {
+		rel, err := filepath.Rel(b.SyncRootPath, filepath.Join(b.BundleRootPath, arg))
+		if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			return nil, fmt.Errorf("path %s is not within the synchronized files", arg)
+		}
+		filters = append(filters, filepath.ToSlash(rel))
+	}
+	return filters, nil
+}
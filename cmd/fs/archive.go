@@ -0,0 +1,272 @@
+package fs
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/databricks/cli/libs/filer"
+	"github.com/databricks/cli/libs/utils"
+)
+
+type archiveFormat string
+
+const (
+	archiveFormatTar   = archiveFormat("tar")
+	archiveFormatTarGz = archiveFormat("tar.gz")
+	archiveFormatZip   = archiveFormat("zip")
+)
+
+// archiveFormatForPath returns the format of an archive based on its file extension.
+func archiveFormatForPath(p string) (archiveFormat, error) {
+	name := strings.ToLower(path.Base(p))
+	switch {
+	case strings.HasSuffix(name, ".tar"):
+		return archiveFormatTar, nil
+	case strings.HasSuffix(name, ".tar.gz"), strings.HasSuffix(name, ".tgz"):
+		return archiveFormatTarGz, nil
+	case strings.HasSuffix(name, ".zip"):
+		return archiveFormatZip, nil
+	default:
+		return "", fmt.Errorf("cannot determine the archive format of %s: expected a .tar, .tar.gz, .tgz or .zip extension", p)
+	}
+}
+
+// archiveWriter writes entries to an archive one at a time.
+type archiveWriter interface {
+	writeDir(name string, modTime time.Time) error
+	writeFile(name string, info fs.FileInfo, r io.Reader) error
+	Close() error
+}
+
+func newArchiveWriter(w io.Writer, format archiveFormat) archiveWriter {
+	switch format {
+	case archiveFormatTarGz:
+		gw := gzip.NewWriter(w)
+		return &tarArchiveWriter{w: tar.NewWriter(gw), gw: gw}
+	case archiveFormatZip:
+		return &zipArchiveWriter{w: zip.NewWriter(w)}
+	default:
+		return &tarArchiveWriter{w: tar.NewWriter(w)}
+	}
+}
+
+type tarArchiveWriter struct {
+	w  *tar.Writer
+	gw *gzip.Writer
+}
+
+func (a *tarArchiveWriter) writeDir(name string, modTime time.Time) error {
+	return a.w.WriteHeader(&tar.Header{
+		Typeflag: tar.TypeDir,
+		Name:     name + "/",
+		Mode:     0o755,
+		ModTime:  modTime,
+	})
+}
+
+func (a *tarArchiveWriter) writeFile(name string, info fs.FileInfo, r io.Reader) error {
+	err := a.w.WriteHeader(&tar.Header{
+		Typeflag: tar.TypeReg,
+		Name:     name,
+		Mode:     0o644,
+		Size:     info.Size(),
+		ModTime:  info.ModTime(),
+	})
+	if err != nil {
+		return err
+	}
+
+	// The size in the header must match the content, so the file must not change while it is archived.
+	_, err = io.Copy(a.w, r)
+	return err
+}
+
+func (a *tarArchiveWriter) Close() error {
+	err := a.w.Close()
+	if err != nil || a.gw == nil {
+		return err
+	}
+	return a.gw.Close()
+}
+
+type zipArchiveWriter struct {
+	w *zip.Writer
+}
+
+func (a *zipArchiveWriter) writeDir(name string, modTime time.Time) error {
+	_, err := a.w.CreateHeader(&zip.FileHeader{
+		Name:     name + "/",
+		Modified: modTime,
+	})
+	return err
+}
+
+func (a *zipArchiveWriter) writeFile(name string, info fs.FileInfo, r io.Reader) error {
+	w, err := a.w.CreateHeader(&zip.FileHeader{
+		Name:     name,
+		Method:   zip.Deflate,
+		Modified: info.ModTime(),
+	})
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(w, r)
+	return err
+}
+
+func (a *zipArchiveWriter) Close() error {
+	return a.w.Close()
+}
+
+// writeArchive writes the files and directories in the tree to w, streaming the content of every file.
+// Entries are written in lexical order, so directories precede their contents.
+func writeArchive(ctx context.Context, f filer.Filer, dir string, t *tree, w io.Writer, format archiveFormat, p *progress) error {
+	aw := newArchiveWriter(w, format)
+
+	names := append(utils.SortedKeys(t.dirs), utils.SortedKeys(t.files)...)
+	slices.Sort(names)
+
+	for _, name := range names {
+		if t.dirs[name] {
+			err := aw.writeDir(name, time.Now())
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		err := writeArchiveFile(ctx, f, path.Join(dir, name), name, t.files[name], aw, p)
+		if err != nil {
+			return err
+		}
+	}
+
+	return aw.Close()
+}
+
+func writeArchiveFile(ctx context.Context, f filer.Filer, filePath, name string, info fs.FileInfo, aw archiveWriter, p *progress) error {
+	r, err := f.Read(ctx, filePath)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	reader := io.Reader(r)
+	if p != nil {
+		reader = p.reader(reader)
+		defer p.fileDone()
+	}
+	return aw.writeFile(name, info, reader)
+}
+
+// archiveEntry is a file or directory read from an archive.
+type archiveEntry struct {
+	name  string
+	isDir bool
+	r     io.Reader
+}
+
+// readArchive calls fn for every file and directory in the archive.
+// The reader of an entry is only valid until fn returns.
+// Entries that are neither a file nor a directory, e.g. symbolic links, are skipped.
+func readArchive(r io.Reader, format archiveFormat, fn func(e archiveEntry) error) error {
+	switch format {
+	case archiveFormatTarGz:
+		gr, err := gzip.NewReader(r)
+		if err != nil {
+			return err
+		}
+		defer gr.Close()
+		return readTarArchive(gr, fn)
+	case archiveFormatZip:
+		return readZipArchive(r, fn)
+	default:
+		return readTarArchive(r, fn)
+	}
+}
+
+func readTarArchive(r io.Reader, fn func(e archiveEntry) error) error {
+	tr := tar.NewReader(r)
+	for {
+		header, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		switch header.Typeflag {
+		case tar.TypeDir:
+			err = fn(archiveEntry{name: header.Name, isDir: true})
+		case tar.TypeReg:
+			err = fn(archiveEntry{name: header.Name, r: tr})
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// readZipArchive reads a zip archive. Its index is stored at the end, so the archive
+// is first written to a temporary file instead of being held in memory.
+func readZipArchive(r io.Reader, fn func(e archiveEntry) error) error {
+	tmp, err := os.CreateTemp("", "databricks-fs-*.zip")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+
+	size, err := io.Copy(tmp, r)
+	if err != nil {
+		return err
+	}
+
+	zr, err := zip.NewReader(tmp, size)
+	if err != nil {
+		return fmt.Errorf("zip: %w", err)
+	}
+
+	for _, zf := range zr.File {
+		if zf.FileInfo().IsDir() {
+			err = fn(archiveEntry{name: zf.Name, isDir: true})
+		} else if zf.Mode().IsRegular() {
+			err = readZipFile(zf, fn)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func readZipFile(zf *zip.File, fn func(e archiveEntry) error) error {
+	r, err := zf.Open()
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+	return fn(archiveEntry{name: zf.Name, r: r})
+}
+
+// archiveEntryPath returns the slash-separated relative path of an archive entry.
+// It returns an error if the entry would be extracted outside the target directory.
+func archiveEntryPath(name string) (string, error) {
+	p := path.Clean(strings.ReplaceAll(name, "\\", "/"))
+	if path.IsAbs(p) || p == ".." || strings.HasPrefix(p, "../") {
+		return "", fmt.Errorf("archive entry %s is outside of the target directory", name)
+	}
+	return p, nil
+}
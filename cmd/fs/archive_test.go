@@ -0,0 +1,119 @@
+package fs
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/databricks/cli/internal/testutil"
+	"github.com/databricks/cli/libs/cmdio"
+	"github.com/databricks/cli/libs/filer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestArchiveFormatForPath(t *testing.T) {
+	for p, expected := range map[string]archiveFormat{
+		"/a/b.tar":    archiveFormatTar,
+		"/a/b.tar.gz": archiveFormatTarGz,
+		"/a/b.TGZ":    archiveFormatTarGz,
+		"/a/b.zip":    archiveFormatZip,
+	} {
+		format, err := archiveFormatForPath(p)
+		require.NoError(t, err)
+		assert.Equal(t, expected, format)
+	}
+
+	_, err := archiveFormatForPath("/a/b.txt")
+	assert.ErrorContains(t, err, "cannot determine the archive format of /a/b.txt")
+}
+
+func TestArchiveEntryPath(t *testing.T) {
+	for name, expected := range map[string]string{
+		"a/b.txt":   "a/b.txt",
+		"./a/b.txt": "a/b.txt",
+		"a/":        "a",
+		`a\b.txt`:   "a/b.txt",
+		"a/../b":    "b",
+	} {
+		p, err := archiveEntryPath(name)
+		require.NoError(t, err)
+		assert.Equal(t, expected, p)
+	}
+
+	for _, name := range []string{"/etc/passwd", "../a", "a/../../b", ".."} {
+		_, err := archiveEntryPath(name)
+		assert.ErrorContains(t, err, "is outside of the target directory")
+	}
+}
+
+func TestCopyArchiveAndExtract(t *testing.T) {
+	for _, ext := range []string{"tar", "tar.gz", "zip"} {
+		t.Run(ext, func(t *testing.T) {
+			dir := t.TempDir()
+			testutil.WriteFile(t, filepath.Join(dir, "src", "a.txt"), "a")
+			testutil.WriteFile(t, filepath.Join(dir, "src", "b", "c.txt"), "c")
+			testutil.Touch(t, dir, "src", "empty", ".keep")
+
+			c := newTestCopy(t)
+			c.archive = true
+			archivePath := filepath.Join(dir, "src."+ext)
+			require.NoError(t, c.run(filepath.Join(dir, "src"), archivePath))
+			assert.FileExists(t, archivePath)
+
+			e := &extract{
+				ctx:         cmdio.MockDiscard(context.Background()),
+				sourceFiler: c.sourceFiler,
+				targetFiler: c.targetFiler,
+			}
+			require.NoError(t, e.run(archivePath, filepath.Join(dir, "dst")))
+			assert.Equal(t, "a", testutil.ReadFile(t, filepath.Join(dir, "dst", "a.txt")))
+			assert.Equal(t, "c", testutil.ReadFile(t, filepath.Join(dir, "dst", "b", "c.txt")))
+			assert.FileExists(t, filepath.Join(dir, "dst", "empty", ".keep"))
+
+			// Existing files are only replaced with --overwrite.
+			testutil.WriteFile(t, filepath.Join(dir, "dst", "a.txt"), "changed")
+			require.NoError(t, e.run(archivePath, filepath.Join(dir, "dst")))
+			assert.Equal(t, "changed", testutil.ReadFile(t, filepath.Join(dir, "dst", "a.txt")))
+
+			e.overwrite = true
+			require.NoError(t, e.run(archivePath, filepath.Join(dir, "dst")))
+			assert.Equal(t, "a", testutil.ReadFile(t, filepath.Join(dir, "dst", "a.txt")))
+		})
+	}
+}
+
+func TestCopyArchiveRequiresDirectory(t *testing.T) {
+	dir := t.TempDir()
+	testutil.WriteFile(t, filepath.Join(dir, "a.txt"), "a")
+
+	c := newTestCopy(t)
+	c.archive = true
+	err := c.run(filepath.Join(dir, "a.txt"), filepath.Join(dir, "a.tar"))
+	assert.ErrorContains(t, err, "must be a directory")
+}
+
+func TestExtractRejectsPathTraversal(t *testing.T) {
+	dir := t.TempDir()
+
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	require.NoError(t, tw.WriteHeader(&tar.Header{Typeflag: tar.TypeReg, Name: "../evil.txt", Mode: 0o644, Size: 4}))
+	_, err := tw.Write([]byte("evil"))
+	require.NoError(t, err)
+	require.NoError(t, tw.Close())
+	testutil.WriteFile(t, filepath.Join(dir, "evil.tar"), buf.String())
+
+	f, err := filer.NewLocalClient("")
+	require.NoError(t, err)
+	e := &extract{
+		ctx:         cmdio.MockDiscard(context.Background()),
+		sourceFiler: f,
+		targetFiler: f,
+	}
+	err = e.run(filepath.Join(dir, "evil.tar"), filepath.Join(dir, "dst"))
+	assert.ErrorContains(t, err, "is outside of the target directory")
+	assert.NoFileExists(t, filepath.Join(dir, "evil.txt"))
+}
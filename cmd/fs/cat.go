@@ -8,9 +8,13 @@ import (
 
 func newCatCommand() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "cat FILE_PATH",
-		Short:   "Show file content.",
-		Long:    `Show the contents of a file in DBFS or a UC Volume.`,
+		Use:   "cat FILE_PATH",
+		Short: "Show file content.",
+		Long: `Show the contents of a file in DBFS, a UC Volume or the workspace.
+
+	  FILE_PATH may contain a glob pattern, e.g. dbfs:/logs/2025-*/part-*.json,
+	  to show the contents of all matching files one after another.
+	`,
 		Args:    root.ExactArgs(1),
 		PreRunE: root.MustWorkspaceClient,
 	}
@@ -23,11 +27,23 @@ func newCatCommand() *cobra.Command {
 			return err
 		}
 
-		r, err := f.Read(ctx, path)
+		paths, err := expandGlob(ctx, f, args[0], path)
 		if err != nil {
 			return err
 		}
-		return cmdio.Render(ctx, r)
+
+		for _, p := range paths {
+			r, err := f.Read(ctx, p)
+			if err != nil {
+				return err
+			}
+			err = cmdio.Render(ctx, r)
+			r.Close()
+			if err != nil {
+				return err
+			}
+		}
+		return nil
 	}
 
 	v := newValidArgs()
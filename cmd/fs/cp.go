@@ -4,29 +4,55 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"io/fs"
 	"path"
 	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
 
 	"github.com/databricks/cli/cmd/root"
 	"github.com/databricks/cli/libs/cmdio"
 	"github.com/databricks/cli/libs/filer"
 	"github.com/spf13/cobra"
+	"golang.org/x/sync/errgroup"
 )
 
 type copy struct {
-	overwrite bool
-	recursive bool
+	overwrite   bool
+	recursive   bool
+	concurrency int
+	dryRun      bool
+	verify      bool
+	archive     bool
+
+	// Don't emit an event for every file.
+	quiet bool
 
 	ctx          context.Context
 	sourceFiler  filer.Filer
 	targetFiler  filer.Filer
 	sourceScheme string
 	targetScheme string
+
+	progress *progress
+
+	// Guards output because files are copied in parallel.
+	mu sync.Mutex
 }
 
-func (c *copy) cpWriteCallback(sourceDir, targetDir string) fs.WalkDirFunc {
-	return func(sourcePath string, d fs.DirEntry, err error) error {
+func (c *copy) cpDirToDir(sourceDir, targetDir string) error {
+	if !c.recursive {
+		return fmt.Errorf("source path %s is a directory. Please specify the --recursive flag", sourceDir)
+	}
+
+	// Create the directories while walking the source directory and collect the files to copy.
+	// Directories are created in walk order, so parent directories exist before their children.
+	var sourcePaths, targetPaths []string
+	var totalBytes int64
+	sourceFs := filer.NewFS(c.ctx, c.sourceFiler)
+	err := fs.WalkDir(sourceFs, sourceDir, func(sourcePath string, d fs.DirEntry, err error) error {
 		if err != nil {
 			return err
 		}
@@ -43,44 +69,138 @@ func (c *copy) cpWriteCallback(sourceDir, targetDir string) fs.WalkDirFunc {
 
 		// create directory and return early
 		if d.IsDir() {
+			if c.dryRun {
+				return nil
+			}
 			return c.targetFiler.Mkdir(c.ctx, targetPath)
 		}
 
-		return c.cpFileToFile(sourcePath, targetPath)
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+
+		sourcePaths = append(sourcePaths, sourcePath)
+		targetPaths = append(targetPaths, targetPath)
+		totalBytes += info.Size()
+		return nil
+	})
+	if err != nil {
+		return err
 	}
+
+	c.progress = newProgress(int64(len(sourcePaths)), totalBytes)
+	if !c.dryRun {
+		defer c.progress.show(c.ctx)()
+	}
+
+	g, ctx := errgroup.WithContext(c.ctx)
+	g.SetLimit(c.concurrency)
+	for i := range sourcePaths {
+		g.Go(func() error {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			return c.cpFileToFile(ctx, sourcePaths[i], targetPaths[i])
+		})
+	}
+	return g.Wait()
 }
 
-func (c *copy) cpDirToDir(sourceDir, targetDir string) error {
-	if !c.recursive {
-		return fmt.Errorf("source path %s is a directory. Please specify the --recursive flag", sourceDir)
+// cpDirToArchive streams all files in the source directory as a single archive to the target path.
+// The archive is written while it is uploaded, so it is never held in memory or on disk.
+func (c *copy) cpDirToArchive(sourceDir, targetPath string) error {
+	format, err := archiveFormatForPath(targetPath)
+	if err != nil {
+		return err
 	}
 
-	sourceFs := filer.NewFS(c.ctx, c.sourceFiler)
-	return fs.WalkDir(sourceFs, sourceDir, c.cpWriteCallback(sourceDir, targetDir))
+	t, err := listTree(c.ctx, c.sourceFiler, sourceDir, selectAll)
+	if err != nil {
+		return err
+	}
+
+	if c.dryRun {
+		return c.emitFileCopiedEvent(sourceDir, targetPath)
+	}
+
+	var totalBytes int64
+	for _, info := range t.files {
+		totalBytes += info.Size()
+	}
+	c.progress = newProgress(int64(len(t.files)), totalBytes)
+	defer c.progress.show(c.ctx)()
+
+	pr, pw := io.Pipe()
+	archiveErr := make(chan error, 1)
+	go func() {
+		err := writeArchive(c.ctx, c.sourceFiler, sourceDir, t, pw, format, c.progress)
+		pw.CloseWithError(err)
+		archiveErr <- err
+	}()
+
+	var modes []filer.WriteMode
+	if c.overwrite {
+		modes = append(modes, filer.OverwriteIfExists)
+	}
+	err = c.targetFiler.Write(c.ctx, targetPath, pr, modes...)
+
+	// Unblock the archive writer if the upload failed before reading the whole archive.
+	pr.CloseWithError(err)
+	if werr := <-archiveErr; err == nil {
+		err = werr
+	}
+
+	if errors.Is(err, fs.ErrExist) {
+		return c.emitFileSkippedEvent(sourceDir, targetPath)
+	}
+	if err != nil {
+		return err
+	}
+	return c.emitFileCopiedEvent(sourceDir, targetPath)
 }
 
 func (c *copy) cpFileToDir(sourcePath, targetDir string) error {
 	fileName := filepath.Base(sourcePath)
 	targetPath := path.Join(targetDir, fileName)
 
-	return c.cpFileToFile(sourcePath, targetPath)
+	return c.cpFileToFile(c.ctx, sourcePath, targetPath)
 }
 
-func (c *copy) cpFileToFile(sourcePath, targetPath string) error {
+// cpFileToFile copies the file at the source path to the target path. The filers are called with
+// the given context, so that copies running in parallel stop as soon as one of them fails.
+func (c *copy) cpFileToFile(ctx context.Context, sourcePath, targetPath string) error {
+	if c.dryRun {
+		return c.dryRunFileToFile(ctx, sourcePath, targetPath)
+	}
+
 	// Get reader for file at source path
-	r, err := c.sourceFiler.Read(c.ctx, sourcePath)
+	r, err := c.sourceFiler.Read(ctx, sourcePath)
 	if err != nil {
 		return err
 	}
 	defer r.Close()
 
+	reader := io.Reader(r)
+	if c.progress != nil {
+		reader = c.progress.reader(reader)
+		defer c.progress.fileDone()
+	}
+
+	// Compute the checksum of the source file while it is copied.
+	var cr *checksumReader
+	if c.verify {
+		cr = newChecksumReader(reader)
+		reader = cr
+	}
+
 	if c.overwrite {
-		err = c.targetFiler.Write(c.ctx, targetPath, r, filer.OverwriteIfExists)
+		err = c.targetFiler.Write(ctx, targetPath, reader, filer.OverwriteIfExists)
 		if err != nil {
 			return err
 		}
 	} else {
-		err = c.targetFiler.Write(c.ctx, targetPath, r)
+		err = c.targetFiler.Write(ctx, targetPath, reader)
 		// skip if file already exists
 		if err != nil && errors.Is(err, fs.ErrExist) {
 			return c.emitFileSkippedEvent(sourcePath, targetPath)
@@ -89,40 +209,157 @@ func (c *copy) cpFileToFile(sourcePath, targetPath string) error {
 			return err
 		}
 	}
+
+	if c.verify {
+		ok, err := fileMatches(ctx, c.targetFiler, targetPath, cr.n, cr.checksums())
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return fmt.Errorf("verification failed: %s does not match %s", fullPath(c.targetScheme, targetPath), fullPath(c.sourceScheme, sourcePath))
+		}
+	}
 	return c.emitFileCopiedEvent(sourcePath, targetPath)
 }
 
-// TODO: emit these events on stderr
-// TODO: add integration tests for these events
-func (c *copy) emitFileSkippedEvent(sourcePath, targetPath string) error {
-	fullSourcePath := sourcePath
-	if c.sourceScheme != "" {
-		fullSourcePath = path.Join(c.sourceScheme+":", sourcePath)
+// dryRunFileToFile emits the event for copying a file without copying it.
+func (c *copy) dryRunFileToFile(ctx context.Context, sourcePath, targetPath string) error {
+	if !c.overwrite {
+		_, err := c.targetFiler.Stat(ctx, targetPath)
+		if err == nil {
+			return c.emitFileSkippedEvent(sourcePath, targetPath)
+		}
+		if !errors.Is(err, fs.ErrNotExist) {
+			return err
+		}
 	}
-	fullTargetPath := targetPath
-	if c.targetScheme != "" {
-		fullTargetPath = path.Join(c.targetScheme+":", targetPath)
+
+	return c.emitFileCopiedEvent(sourcePath, targetPath)
+}
+
+// run copies the file or directory at the source path to the target path.
+func (c *copy) run(sourcePath, targetPath string) error {
+	// Get information about file at source path
+	sourceInfo, err := c.sourceFiler.Stat(c.ctx, sourcePath)
+	if err != nil {
+		return err
 	}
 
-	event := newFileSkippedEvent(fullSourcePath, fullTargetPath)
-	template := "{{.SourcePath}} -> {{.TargetPath}} (skipped; already exists)\n"
+	if c.archive {
+		if !sourceInfo.IsDir() {
+			return fmt.Errorf("source path %s must be a directory when the --archive flag is specified", fullPath(c.sourceScheme, sourcePath))
+		}
+		return c.cpDirToArchive(sourcePath, targetPath)
+	}
 
-	return cmdio.RenderWithTemplate(c.ctx, event, "", template)
+	// case 1: source path is a directory, then recursively create files at target path
+	if sourceInfo.IsDir() {
+		return c.cpDirToDir(sourcePath, targetPath)
+	}
+
+	c.progress = newProgress(1, sourceInfo.Size())
+	if !c.dryRun {
+		defer c.progress.show(c.ctx)()
+	}
+
+	// case 2: source path is a file, and target path is a directory. In this case
+	// we copy the file to inside the directory
+	if targetInfo, err := c.targetFiler.Stat(c.ctx, targetPath); err == nil && targetInfo.IsDir() {
+		return c.cpFileToDir(sourcePath, targetPath)
+	}
+
+	// case 3: source path is a file, and target path is a file
+	return c.cpFileToFile(c.ctx, sourcePath, targetPath)
 }
 
-func (c *copy) emitFileCopiedEvent(sourcePath, targetPath string) error {
-	fullSourcePath := sourcePath
-	if c.sourceScheme != "" {
-		fullSourcePath = path.Join(c.sourceScheme+":", sourcePath)
+// runGlob copies the files and directories that match the source pattern into the target directory.
+// Matches keep their path relative to the part of the pattern before the first special character.
+func (c *copy) runGlob(fullSourcePattern, sourcePattern, fullTargetPath, targetDir string) error {
+	sourcePaths, err := expandGlob(c.ctx, c.sourceFiler, fullSourcePattern, sourcePattern)
+	if err != nil {
+		return err
 	}
-	fullTargetPath := targetPath
-	if c.targetScheme != "" {
-		fullTargetPath = path.Join(c.targetScheme+":", targetPath)
+
+	// Glob patterns always use the "/" separator.
+	pattern := sourcePattern
+	if schemeForPath(fullSourcePattern) == "" {
+		pattern = filepath.ToSlash(pattern)
+	}
+
+	// The pattern is the literal path of an existing file or directory, e.g. "my[1].txt".
+	if len(sourcePaths) == 1 && sourcePaths[0] == pattern {
+		return c.run(sourcePattern, targetDir)
+	}
+
+	targetInfo, err := c.targetFiler.Stat(c.ctx, targetDir)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	if err != nil || !targetInfo.IsDir() {
+		return fmt.Errorf("target path %s must be an existing directory when the source path is a pattern", fullTargetPath)
 	}
 
-	event := newFileCopiedEvent(fullSourcePath, fullTargetPath)
-	template := "{{.SourcePath}} -> {{.TargetPath}}\n"
+	prefix := filer.GlobPrefix(pattern)
+	for _, sourcePath := range withoutNestedPaths(sourcePaths) {
+		relPath := strings.TrimPrefix(strings.TrimPrefix(sourcePath, prefix), "/")
+		targetPath := path.Join(targetDir, relPath)
 
+		// Create the directories between the target directory and the copied file or directory.
+		if dir := path.Dir(relPath); dir != "." && !c.dryRun {
+			err = c.targetFiler.Mkdir(c.ctx, path.Join(targetDir, dir))
+			if err != nil {
+				return err
+			}
+		}
+
+		err = c.run(sourcePath, targetPath)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// withoutNestedPaths removes the paths that are located below another path in the list, such as the files
+// of a matched directory, because they are copied along with it. The paths must be sorted.
+func withoutNestedPaths(paths []string) []string {
+	var out []string
+	for _, p := range paths {
+		nested := slices.ContainsFunc(out, func(parent string) bool {
+			return strings.HasPrefix(p, strings.TrimSuffix(parent, "/")+"/")
+		})
+		if !nested {
+			out = append(out, p)
+		}
+	}
+	return out
+}
+
+// TODO: emit these events on stderr
+// TODO: add integration tests for these events
+func (c *copy) emitFileSkippedEvent(sourcePath, targetPath string) error {
+	event := newFileSkippedEvent(fullPath(c.sourceScheme, sourcePath), fullPath(c.targetScheme, targetPath))
+	event.DryRun = c.dryRun
+	template := "{{if .DryRun}}(dry run) {{end}}{{.SourcePath}} -> {{.TargetPath}} (skipped; already exists)\n"
+
+	return c.render(event, template)
+}
+
+func (c *copy) emitFileCopiedEvent(sourcePath, targetPath string) error {
+	event := newFileCopiedEvent(fullPath(c.sourceScheme, sourcePath), fullPath(c.targetScheme, targetPath))
+	event.DryRun = c.dryRun
+	template := "{{if .DryRun}}(dry run) {{end}}{{.SourcePath}} -> {{.TargetPath}}\n"
+
+	return c.render(event, template)
+}
+
+func (c *copy) render(event fileIOEvent, template string) error {
+	if c.quiet {
+		return nil
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
 	return cmdio.RenderWithTemplate(c.ctx, event, "", template)
 }
 
@@ -130,16 +367,42 @@ func newCpCommand() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "cp SOURCE_PATH TARGET_PATH",
 		Short: "Copy files and directories.",
-		Long: `Copy files and directories to and from any paths on DBFS, UC Volumes or your local filesystem.
+		Long: `Copy files and directories to and from any paths on DBFS, UC Volumes, the workspace or your local filesystem.
 
 	  For paths in DBFS and UC Volumes, it is required that you specify the "dbfs" scheme.
 	  For example: dbfs:/foo/bar.
 
+	  For workspace files and notebooks, specify the "ws" or "workspace" scheme.
+	  For example: ws:/Users/someone@example.com/project. Notebooks are read and
+	  written in their source format, e.g. notebook.py for a Python notebook.
+
 	  Recursively copying a directory will copy all files inside directory
-	  at SOURCE_PATH to the directory at TARGET_PATH.
+	  at SOURCE_PATH to the directory at TARGET_PATH. Files are copied in parallel;
+	  use --concurrency to control how many files are copied at the same time.
 
 	  When copying a file, if TARGET_PATH is a directory, the file will be created
 	  inside the directory, otherwise the file is created at TARGET_PATH.
+
+	  SOURCE_PATH may contain a glob pattern, e.g. dbfs:/logs/2025-*/part-*.json.
+	  Patterns support "*", "?" and "[...]" as in the shell, and "**" to match any
+	  number of directories. All matching files and directories are copied into
+	  TARGET_PATH, which must be an existing directory. They keep their path
+	  relative to the directory before the first pattern element, e.g. the files
+	  above are copied to TARGET_PATH/2025-01/part-0.json and so on. A path that
+	  contains special characters but exists as is, e.g. my[1].txt, is copied as is.
+
+	  Use --dry-run to show the files that would be copied without copying them.
+
+	  Copying many small files is dominated by the overhead of a request per file.
+	  With --archive, the directory at SOURCE_PATH is instead streamed as a single
+	  archive to the file at TARGET_PATH. The archive format is determined by the
+	  extension of TARGET_PATH: .tar, .tar.gz, .tgz or .zip. Use "fs extract" to
+	  unpack the archive.
+
+	  With --verify, the checksum of every file is computed while it is copied and
+	  compared with the checksum in the metadata of the copied file. If the storage
+	  doesn't provide one, the copied file is read back to compute its checksum.
+	  The command fails if they don't match.
 	`,
 		Args:    root.ExactArgs(2),
 		PreRunE: root.MustWorkspaceClient,
@@ -148,10 +411,18 @@ func newCpCommand() *cobra.Command {
 	var c copy
 	cmd.Flags().BoolVar(&c.overwrite, "overwrite", false, "overwrite existing files")
 	cmd.Flags().BoolVarP(&c.recursive, "recursive", "r", false, "recursively copy files from directory")
+	cmd.Flags().IntVar(&c.concurrency, "concurrency", 10, "number of files to copy in parallel when copying a directory")
+	cmd.Flags().BoolVar(&c.dryRun, "dry-run", false, "show the files that would be copied without copying them")
+	cmd.Flags().BoolVar(&c.verify, "verify", false, "verify the checksum of every copied file")
+	cmd.Flags().BoolVar(&c.archive, "archive", false, "copy a directory as a single tar or zip archive")
 
 	cmd.RunE = func(cmd *cobra.Command, args []string) error {
 		ctx := cmd.Context()
 
+		if c.concurrency < 1 {
+			return errors.New("--concurrency must be at least 1")
+		}
+
 		// Get source filer and source path without scheme
 		fullSourcePath := args[0]
 		sourceFiler, sourcePath, err := filerForPath(ctx, fullSourcePath)
@@ -166,38 +437,20 @@ func newCpCommand() *cobra.Command {
 			return err
 		}
 
-		c.sourceScheme = ""
-		if isDbfsPath(fullSourcePath) {
-			c.sourceScheme = "dbfs"
-		}
-		c.targetScheme = ""
-		if isDbfsPath(fullTargetPath) {
-			c.targetScheme = "dbfs"
-		}
+		c.sourceScheme = schemeForPath(fullSourcePath)
+		c.targetScheme = schemeForPath(fullTargetPath)
 
 		c.ctx = ctx
 		c.sourceFiler = sourceFiler
 		c.targetFiler = targetFiler
 
-		// Get information about file at source path
-		sourceInfo, err := sourceFiler.Stat(ctx, sourcePath)
-		if err != nil {
-			return err
-		}
-
-		// case 1: source path is a directory, then recursively create files at target path
-		if sourceInfo.IsDir() {
-			return c.cpDirToDir(sourcePath, targetPath)
+		if filer.HasGlob(sourcePath) {
+			if c.archive {
+				return errors.New("the --archive flag cannot be used with a pattern")
+			}
+			return c.runGlob(fullSourcePath, sourcePath, fullTargetPath, targetPath)
 		}
-
-		// case 2: source path is a file, and target path is a directory. In this case
-		// we copy the file to inside the directory
-		if targetInfo, err := targetFiler.Stat(ctx, targetPath); err == nil && targetInfo.IsDir() {
-			return c.cpFileToDir(sourcePath, targetPath)
-		}
-
-		// case 3: source path is a file, and target path is a file
-		return c.cpFileToFile(sourcePath, targetPath)
+		return c.run(sourcePath, targetPath)
 	}
 
 	v := newValidArgs()
@@ -0,0 +1,182 @@
+package fs
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/databricks/cli/internal/testutil"
+	"github.com/databricks/cli/libs/cmdio"
+	"github.com/databricks/cli/libs/filer"
+	"github.com/databricks/cli/libs/flags"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestCopy(t *testing.T) *copy {
+	f, err := filer.NewLocalClient("")
+	require.NoError(t, err)
+
+	return &copy{
+		recursive:   true,
+		concurrency: 2,
+
+		ctx:         cmdio.MockDiscard(context.Background()),
+		sourceFiler: f,
+		targetFiler: f,
+	}
+}
+
+func TestCopyGlob(t *testing.T) {
+	dir := filepath.ToSlash(t.TempDir())
+	testutil.WriteFile(t, filepath.Join(dir, "src", "2025-01", "part-0.json"), "a")
+	testutil.WriteFile(t, filepath.Join(dir, "src", "2025-01", "part-1.csv"), "b")
+	testutil.WriteFile(t, filepath.Join(dir, "src", "2025-02", "part-0.json"), "c")
+	testutil.Touch(t, dir, "dst", ".keep")
+
+	c := newTestCopy(t)
+	src := dir + "/src/2025-*/*.json"
+	dst := dir + "/dst"
+	require.NoError(t, c.runGlob(src, src, dst, dst))
+
+	// Matches keep their path relative to the part of the pattern before the first special character.
+	assert.Equal(t, "a", testutil.ReadFile(t, filepath.Join(dir, "dst", "2025-01", "part-0.json")))
+	assert.Equal(t, "c", testutil.ReadFile(t, filepath.Join(dir, "dst", "2025-02", "part-0.json")))
+	assert.NoFileExists(t, filepath.Join(dir, "dst", "2025-01", "part-1.csv"))
+
+	// Matching directories are copied into the target directory.
+	src = dir + "/src/2025-0[1]"
+	require.NoError(t, c.runGlob(src, src, dst, dst))
+	assert.Equal(t, "b", testutil.ReadFile(t, filepath.Join(dir, "dst", "2025-01", "part-1.csv")))
+
+	// The target must be a directory.
+	err := c.runGlob(src, src, dst+"/.keep", dst+"/.keep")
+	assert.ErrorContains(t, err, "must be an existing directory")
+
+	// There must be at least one match.
+	src = dir + "/src/*.txt"
+	err = c.runGlob(src, src, dst, dst)
+	assert.ErrorContains(t, err, "no matches found for "+src)
+}
+
+func TestCopyGlobNestedMatches(t *testing.T) {
+	dir := filepath.ToSlash(t.TempDir())
+	testutil.WriteFile(t, filepath.Join(dir, "src", "a", "b.txt"), "b")
+	testutil.Touch(t, dir, "dst", ".keep")
+
+	var events bytes.Buffer
+	c := newTestCopy(t)
+	c.ctx = cmdio.InContext(context.Background(), cmdio.NewIO(context.Background(), flags.OutputText, nil, &events, &events, "", ""))
+
+	// The file matches "**" on its own and as part of the matching directory; it is copied once.
+	src := dir + "/src/**"
+	dst := dir + "/dst"
+	require.NoError(t, c.runGlob(src, src, dst, dst))
+	assert.Equal(t, "b", testutil.ReadFile(t, filepath.Join(dir, "dst", "a", "b.txt")))
+	assert.Equal(t, 1, strings.Count(events.String(), "\n"), events.String())
+}
+
+func TestCopyGlobLiteralPath(t *testing.T) {
+	dir := filepath.ToSlash(t.TempDir())
+	testutil.WriteFile(t, filepath.Join(dir, "my[1].txt"), "a")
+
+	// A path with special characters that exists as is is copied as is.
+	c := newTestCopy(t)
+	src := dir + "/my[1].txt"
+	dst := dir + "/copy.txt"
+	require.NoError(t, c.runGlob(src, src, dst, dst))
+	assert.Equal(t, "a", testutil.ReadFile(t, filepath.Join(dir, "copy.txt")))
+}
+
+func TestCopyDryRun(t *testing.T) {
+	dir := t.TempDir()
+	testutil.WriteFile(t, filepath.Join(dir, "src", "a.txt"), "a")
+	testutil.WriteFile(t, filepath.Join(dir, "src", "b", "c.txt"), "c")
+
+	c := newTestCopy(t)
+	c.dryRun = true
+	require.NoError(t, c.run(filepath.Join(dir, "src"), filepath.Join(dir, "dst")))
+	assert.NoDirExists(t, filepath.Join(dir, "dst"))
+
+	require.NoError(t, c.run(filepath.Join(dir, "src", "a.txt"), filepath.Join(dir, "a.txt")))
+	assert.NoFileExists(t, filepath.Join(dir, "a.txt"))
+}
+
+// corruptingFiler writes a different byte in place of the first byte of every file.
+type corruptingFiler struct {
+	filer.Filer
+}
+
+func (f corruptingFiler) Write(ctx context.Context, path string, r io.Reader, mode ...filer.WriteMode) error {
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	if len(b) > 0 {
+		b[0]++
+	}
+	return f.Filer.Write(ctx, path, bytes.NewReader(b), mode...)
+}
+
+func TestCopyVerify(t *testing.T) {
+	dir := t.TempDir()
+	testutil.WriteFile(t, filepath.Join(dir, "src", "a.txt"), "abcd")
+
+	c := newTestCopy(t)
+	c.verify = true
+	require.NoError(t, c.run(filepath.Join(dir, "src"), filepath.Join(dir, "dst")))
+	assert.Equal(t, "abcd", testutil.ReadFile(t, filepath.Join(dir, "dst", "a.txt")))
+
+	c.overwrite = true
+	c.targetFiler = corruptingFiler{c.targetFiler}
+	err := c.run(filepath.Join(dir, "src"), filepath.Join(dir, "dst"))
+	assert.ErrorContains(t, err, "verification failed")
+}
+
+// failingFiler fails to write the file named "fail.txt" once the write of another file has started.
+// That write blocks until the context is cancelled.
+type failingFiler struct {
+	filer.Filer
+
+	started chan struct{}
+	blocked chan error
+}
+
+func (f failingFiler) Write(ctx context.Context, path string, r io.Reader, mode ...filer.WriteMode) error {
+	if strings.HasSuffix(path, "fail.txt") {
+		<-f.started
+		return errors.New("write failed")
+	}
+
+	close(f.started)
+	var err error
+	select {
+	case <-ctx.Done():
+		err = ctx.Err()
+	case <-time.After(10 * time.Second):
+		err = errors.New("write was not cancelled")
+	}
+	f.blocked <- err
+	return err
+}
+
+func TestCopyDirCancelsCopiesAfterFailure(t *testing.T) {
+	dir := t.TempDir()
+	testutil.WriteFile(t, filepath.Join(dir, "src", "a.txt"), "a")
+	testutil.WriteFile(t, filepath.Join(dir, "src", "fail.txt"), "b")
+
+	c := newTestCopy(t)
+	f := failingFiler{Filer: c.targetFiler, started: make(chan struct{}), blocked: make(chan error, 1)}
+	c.targetFiler = f
+
+	err := c.run(filepath.Join(dir, "src"), filepath.Join(dir, "dst"))
+	assert.EqualError(t, err, "write failed")
+
+	// The copy of a.txt is cancelled once the copy of fail.txt fails.
+	assert.ErrorIs(t, <-f.blocked, context.Canceled)
+}
@@ -0,0 +1,137 @@
+package fs
+
+import (
+	"context"
+	"io/fs"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/databricks/cli/cmd/root"
+	"github.com/databricks/cli/libs/cmdio"
+	"github.com/databricks/cli/libs/filer"
+	"github.com/spf13/cobra"
+)
+
+type duEntry struct {
+	Path  string `json:"path"`
+	Size  int64  `json:"size"`
+	Files int64  `json:"files"`
+
+	// Human readable size for the text output.
+	HumanSize string `json:"-"`
+}
+
+// diskUsage returns the total size of the files in each entry of the directory,
+// followed by the total for the directory itself.
+func diskUsage(ctx context.Context, f filer.Filer, dir, displayDir string) ([]duEntry, error) {
+	var entries []duEntry
+	index := map[string]int{}
+	total := duEntry{Path: displayDir}
+
+	err := fs.WalkDir(filer.NewFS(ctx, f), dir, func(name string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		relPath, err := filepath.Rel(dir, name)
+		if err != nil {
+			return err
+		}
+		relPath = filepath.ToSlash(relPath)
+		if relPath == "." {
+			return nil
+		}
+
+		// Attribute the file to the top-level entry that contains it.
+		top, _, _ := strings.Cut(relPath, "/")
+		i, ok := index[top]
+		if !ok {
+			i = len(entries)
+			index[top] = i
+			entries = append(entries, duEntry{Path: path.Join(displayDir, top)})
+		}
+
+		if d.IsDir() {
+			return nil
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+
+		entries[i].Size += info.Size()
+		entries[i].Files++
+		total.Size += info.Size()
+		total.Files++
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	entries = append(entries, total)
+	for i := range entries {
+		entries[i].HumanSize = formatBytes(entries[i].Size)
+	}
+	return entries, nil
+}
+
+func newDuCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "du PATH",
+		Short: "Show disk usage.",
+		Long: `Show the total size of the files in a directory in DBFS, a UC Volume, the workspace or your local filesystem.
+
+	  The size of every entry in the directory is shown, followed by the total.
+	  Sizes of directories include all files they contain.
+	`,
+		Args:    root.ExactArgs(1),
+		PreRunE: root.MustWorkspaceClient,
+	}
+
+	var summarize bool
+	var bytes bool
+	cmd.Flags().BoolVarP(&summarize, "summarize", "s", false, "only show the total")
+	cmd.Flags().BoolVar(&bytes, "bytes", false, "show sizes in bytes instead of a human readable format")
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+
+		f, dir, err := filerForPath(ctx, args[0])
+		if err != nil {
+			return err
+		}
+
+		info, err := f.Stat(ctx, dir)
+		if err != nil {
+			return err
+		}
+
+		var entries []duEntry
+		if info.IsDir() {
+			entries, err = diskUsage(ctx, f, dir, args[0])
+			if err != nil {
+				return err
+			}
+		} else {
+			entries = []duEntry{{Path: args[0], Size: info.Size(), Files: 1, HumanSize: formatBytes(info.Size())}}
+		}
+
+		if summarize {
+			entries = entries[len(entries)-1:]
+		}
+
+		size := "{{.HumanSize}}"
+		if bytes {
+			size = "{{.Size}}"
+		}
+		return cmdio.RenderWithTemplate(ctx, entries, "", `{{range .}}`+size+"\t{{.Path}}\n{{end}}")
+	}
+
+	v := newValidArgs()
+	cmd.ValidArgsFunction = v.Validate
+
+	return cmd
+}
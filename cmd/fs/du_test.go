@@ -0,0 +1,31 @@
+package fs
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/databricks/cli/internal/testutil"
+	"github.com/databricks/cli/libs/filer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiskUsage(t *testing.T) {
+	dir := t.TempDir()
+	testutil.WriteFile(t, filepath.Join(dir, "a.txt"), "abc")
+	testutil.WriteFile(t, filepath.Join(dir, "sub", "b.txt"), strings.Repeat("x", 2048))
+	testutil.WriteFile(t, filepath.Join(dir, "sub", "nested", "c.txt"), "c")
+
+	f, err := filer.NewLocalClient("")
+	require.NoError(t, err)
+
+	entries, err := diskUsage(context.Background(), f, dir, "dbfs:/root")
+	require.NoError(t, err)
+	assert.Equal(t, []duEntry{
+		{Path: "dbfs:/root/a.txt", Size: 3, Files: 1, HumanSize: "3 B"},
+		{Path: "dbfs:/root/sub", Size: 2049, Files: 2, HumanSize: "2.0 KiB"},
+		{Path: "dbfs:/root", Size: 2052, Files: 3, HumanSize: "2.0 KiB"},
+	}, entries)
+}
@@ -4,13 +4,17 @@ type fileIOEvent struct {
 	SourcePath string    `json:"source_path,omitempty"`
 	TargetPath string    `json:"target_path,omitempty"`
 	Type       EventType `json:"type"`
+	DryRun     bool      `json:"dry_run,omitempty"`
 }
 
 type EventType string
 
 const (
-	EventTypeFileCopied  = EventType("FILE_COPIED")
-	EventTypeFileSkipped = EventType("FILE_SKIPPED")
+	EventTypeFileCopied    = EventType("FILE_COPIED")
+	EventTypeFileSkipped   = EventType("FILE_SKIPPED")
+	EventTypeFileDeleted   = EventType("FILE_DELETED")
+	EventTypeFileMoved     = EventType("FILE_MOVED")
+	EventTypeFileExtracted = EventType("FILE_EXTRACTED")
 )
 
 func newFileCopiedEvent(sourcePath, targetPath string) fileIOEvent {
@@ -28,3 +32,26 @@ func newFileSkippedEvent(sourcePath, targetPath string) fileIOEvent {
 		Type:       EventTypeFileSkipped,
 	}
 }
+
+func newFileDeletedEvent(targetPath string) fileIOEvent {
+	return fileIOEvent{
+		TargetPath: targetPath,
+		Type:       EventTypeFileDeleted,
+	}
+}
+
+func newFileMovedEvent(sourcePath, targetPath string) fileIOEvent {
+	return fileIOEvent{
+		SourcePath: sourcePath,
+		TargetPath: targetPath,
+		Type:       EventTypeFileMoved,
+	}
+}
+
+func newFileExtractedEvent(sourcePath, targetPath string) fileIOEvent {
+	return fileIOEvent{
+		SourcePath: sourcePath,
+		TargetPath: targetPath,
+		Type:       EventTypeFileExtracted,
+	}
+}
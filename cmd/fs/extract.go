@@ -0,0 +1,125 @@
+package fs
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"path"
+
+	"github.com/databricks/cli/cmd/root"
+	"github.com/databricks/cli/libs/cmdio"
+	"github.com/databricks/cli/libs/filer"
+	"github.com/spf13/cobra"
+)
+
+type extract struct {
+	overwrite bool
+
+	ctx          context.Context
+	sourceFiler  filer.Filer
+	targetFiler  filer.Filer
+	sourceScheme string
+	targetScheme string
+}
+
+func (e *extract) run(archivePath, targetDir string) error {
+	format, err := archiveFormatForPath(archivePath)
+	if err != nil {
+		return err
+	}
+
+	r, err := e.sourceFiler.Read(e.ctx, archivePath)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	err = e.targetFiler.Mkdir(e.ctx, targetDir)
+	if err != nil {
+		return err
+	}
+
+	return readArchive(r, format, func(entry archiveEntry) error {
+		name, err := archiveEntryPath(entry.name)
+		if err != nil {
+			return err
+		}
+		if name == "." {
+			return nil
+		}
+
+		targetPath := path.Join(targetDir, name)
+		if entry.isDir {
+			return e.targetFiler.Mkdir(e.ctx, targetPath)
+		}
+
+		modes := []filer.WriteMode{filer.CreateParentDirectories}
+		if e.overwrite {
+			modes = append(modes, filer.OverwriteIfExists)
+		}
+
+		sourcePath := fullPath(e.sourceScheme, archivePath) + ":" + name
+		err = e.targetFiler.Write(e.ctx, targetPath, entry.r, modes...)
+		if errors.Is(err, fs.ErrExist) {
+			event := newFileSkippedEvent(sourcePath, fullPath(e.targetScheme, targetPath))
+			return cmdio.RenderWithTemplate(e.ctx, event, "", "{{.SourcePath}} -> {{.TargetPath}} (skipped; already exists)\n")
+		}
+		if err != nil {
+			return err
+		}
+
+		event := newFileExtractedEvent(sourcePath, fullPath(e.targetScheme, targetPath))
+		return cmdio.RenderWithTemplate(e.ctx, event, "", "{{.SourcePath}} -> {{.TargetPath}}\n")
+	})
+}
+
+func newExtractCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "extract ARCHIVE_PATH TARGET_DIR",
+		Short: "Extract an archive.",
+		Long: `Extract a tar or zip archive to a directory on DBFS, UC Volumes, the workspace or your local filesystem.
+
+	  The archive format is determined by the extension of ARCHIVE_PATH:
+	  .tar, .tar.gz, .tgz or .zip. Archives created with "fs cp --archive" can
+	  be extracted with this command.
+
+	  Tar archives are extracted while they are downloaded. Zip archives store their
+	  index at the end, so they are downloaded to a temporary file first.
+
+	  Existing files in TARGET_DIR are skipped unless --overwrite is specified.
+	`,
+		Args:    root.ExactArgs(2),
+		PreRunE: root.MustWorkspaceClient,
+	}
+
+	var e extract
+	cmd.Flags().BoolVar(&e.overwrite, "overwrite", false, "overwrite existing files")
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+
+		sourceFiler, sourcePath, err := filerForPath(ctx, args[0])
+		if err != nil {
+			return err
+		}
+
+		targetFiler, targetPath, err := filerForPath(ctx, args[1])
+		if err != nil {
+			return err
+		}
+
+		e.sourceScheme = schemeForPath(args[0])
+		e.targetScheme = schemeForPath(args[1])
+
+		e.ctx = ctx
+		e.sourceFiler = sourceFiler
+		e.targetFiler = targetFiler
+		return e.run(sourcePath, targetPath)
+	}
+
+	v := newValidArgs()
+	v.pathArgCount = 2
+	cmd.ValidArgsFunction = v.Validate
+
+	return cmd
+}
@@ -0,0 +1,252 @@
+package fs
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/databricks/cli/cmd/root"
+	"github.com/databricks/cli/libs/cmdio"
+	"github.com/databricks/cli/libs/filer"
+	"github.com/spf13/cobra"
+)
+
+type findPredicates struct {
+	name     string
+	kind     string
+	minSize  int64
+	maxSize  int64
+	newer    time.Time
+	older    time.Time
+	maxDepth int
+}
+
+func (p *findPredicates) match(name string, info fs.FileInfo) (bool, error) {
+	if p.name != "" {
+		ok, err := path.Match(p.name, path.Base(name))
+		if err != nil || !ok {
+			return false, err
+		}
+	}
+
+	switch p.kind {
+	case "f":
+		if info.IsDir() {
+			return false, nil
+		}
+	case "d":
+		if !info.IsDir() {
+			return false, nil
+		}
+	}
+
+	// Size predicates only apply to files.
+	if p.minSize > 0 && (info.IsDir() || info.Size() < p.minSize) {
+		return false, nil
+	}
+	if p.maxSize >= 0 && (info.IsDir() || info.Size() > p.maxSize) {
+		return false, nil
+	}
+
+	if !p.newer.IsZero() && !info.ModTime().After(p.newer) {
+		return false, nil
+	}
+	if !p.older.IsZero() && !info.ModTime().Before(p.older) {
+		return false, nil
+	}
+
+	return true, nil
+}
+
+// parseSize parses a size in bytes with an optional binary unit, e.g. 512, 10K or 1.5GiB.
+func parseSize(s string) (int64, error) {
+	units := []struct {
+		suffix     string
+		multiplier float64
+	}{
+		{"T", 1 << 40},
+		{"G", 1 << 30},
+		{"M", 1 << 20},
+		{"K", 1 << 10},
+	}
+
+	v := strings.ToUpper(strings.TrimSpace(s))
+	v = strings.TrimSuffix(strings.TrimSuffix(v, "IB"), "B")
+	multiplier := 1.0
+	for _, u := range units {
+		if strings.HasSuffix(v, u.suffix) {
+			v = strings.TrimSuffix(v, u.suffix)
+			multiplier = u.multiplier
+			break
+		}
+	}
+
+	n, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+	if err != nil || n < 0 {
+		return 0, fmt.Errorf("invalid size %q: expected a number of bytes with an optional unit (K, M, G or T)", s)
+	}
+	return int64(n * multiplier), nil
+}
+
+// parseTimeOrAge parses either a point in time (a date or RFC 3339 timestamp)
+// or an age relative to now (a duration like 30m or 7d).
+func parseTimeOrAge(s string, now time.Time) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t, nil
+	}
+	if t, err := time.ParseInLocation(time.DateOnly, s, time.Local); err == nil {
+		return t, nil
+	}
+
+	// Support days, which [time.ParseDuration] does not.
+	if days, ok := strings.CutSuffix(s, "d"); ok {
+		n, err := strconv.Atoi(days)
+		if err == nil && n >= 0 {
+			return now.AddDate(0, 0, -n), nil
+		}
+	}
+	if d, err := time.ParseDuration(s); err == nil && d >= 0 {
+		return now.Add(-d), nil
+	}
+
+	return time.Time{}, fmt.Errorf("invalid time %q: expected a duration (e.g. 24h or 7d), a date (e.g. 2024-01-31) or an RFC 3339 timestamp", s)
+}
+
+func newFindCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "find DIR_PATH",
+		Short: "Find files and directories.",
+		Long: `Find files and directories in DBFS, UC Volumes, the workspace or your local filesystem.
+
+	  Recursively lists all files and directories in DIR_PATH that match all of the
+	  specified predicates.
+
+	  Sizes accept an optional unit, e.g. 512, 10K, 100M or 1G.
+	  Times accept an age relative to now, e.g. 30m, 24h or 7d,
+	  a date, e.g. 2024-01-31, or an RFC 3339 timestamp.
+	`,
+		Args:    root.ExactArgs(1),
+		PreRunE: root.MustWorkspaceClient,
+	}
+
+	var name, kind, minSize, maxSize, newer, older string
+	var maxDepth int
+	cmd.Flags().StringVar(&name, "name", "", "only match entries whose base name matches this glob pattern")
+	cmd.Flags().StringVar(&kind, "type", "", "only match files (f) or directories (d)")
+	cmd.Flags().StringVar(&minSize, "min-size", "", "only match files of at least this size")
+	cmd.Flags().StringVar(&maxSize, "max-size", "", "only match files of at most this size")
+	cmd.Flags().StringVar(&newer, "newer", "", "only match entries modified after this time")
+	cmd.Flags().StringVar(&older, "older", "", "only match entries modified before this time")
+	cmd.Flags().IntVar(&maxDepth, "max-depth", -1, "descend at most this many levels below DIR_PATH")
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+		now := time.Now()
+
+		p := findPredicates{name: name, kind: kind, maxSize: -1, maxDepth: maxDepth}
+		if kind != "" && kind != "f" && kind != "d" {
+			return fmt.Errorf("invalid type %q: expected f or d", kind)
+		}
+		if _, err := path.Match(name, ""); err != nil {
+			return fmt.Errorf("invalid pattern %q: %w", name, err)
+		}
+
+		var err error
+		if minSize != "" {
+			p.minSize, err = parseSize(minSize)
+			if err != nil {
+				return err
+			}
+		}
+		if maxSize != "" {
+			p.maxSize, err = parseSize(maxSize)
+			if err != nil {
+				return err
+			}
+		}
+		if newer != "" {
+			p.newer, err = parseTimeOrAge(newer, now)
+			if err != nil {
+				return err
+			}
+		}
+		if older != "" {
+			p.older, err = parseTimeOrAge(older, now)
+			if err != nil {
+				return err
+			}
+		}
+
+		f, dir, err := filerForPath(ctx, args[0])
+		if err != nil {
+			return err
+		}
+
+		entries, err := find(ctx, f, dir, args[0], &p)
+		if err != nil {
+			return err
+		}
+
+		return cmdio.RenderWithTemplate(ctx, entries, "", cmdio.Heredoc(`
+		{{range .}}{{.Name}}
+		{{end}}
+		`))
+	}
+
+	v := newValidArgs()
+	v.onlyDirs = true
+	cmd.ValidArgsFunction = v.Validate
+
+	return cmd
+}
+
+func find(ctx context.Context, f filer.Filer, dir, displayDir string, p *findPredicates) ([]jsonDirEntry, error) {
+	entries := []jsonDirEntry{}
+	err := fs.WalkDir(filer.NewFS(ctx, f), dir, func(name string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		relPath, err := filepath.Rel(dir, name)
+		if err != nil {
+			return err
+		}
+		relPath = filepath.ToSlash(relPath)
+		if relPath == "." {
+			return nil
+		}
+
+		depth := strings.Count(relPath, "/") + 1
+		if p.maxDepth >= 0 && depth > p.maxDepth {
+			if d.IsDir() {
+				return fs.SkipDir
+			}
+			return nil
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+
+		ok, err := p.match(name, info)
+		if err != nil {
+			return err
+		}
+		if ok {
+			entries = append(entries, jsonDirEntry{
+				Name:    path.Join(displayDir, relPath),
+				IsDir:   info.IsDir(),
+				Size:    info.Size(),
+				ModTime: info.ModTime(),
+			})
+		}
+		return nil
+	})
+	return entries, err
+}
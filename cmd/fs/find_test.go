@@ -0,0 +1,116 @@
+package fs
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/databricks/cli/internal/testutil"
+	"github.com/databricks/cli/libs/filer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSize(t *testing.T) {
+	for input, expected := range map[string]int64{
+		"0":      0,
+		"512":    512,
+		"512B":   512,
+		"10K":    10 * 1024,
+		"10kb":   10 * 1024,
+		"1.5MiB": 3 * 512 * 1024,
+		"2G":     2 * 1024 * 1024 * 1024,
+		"1T":     1024 * 1024 * 1024 * 1024,
+	} {
+		actual, err := parseSize(input)
+		require.NoError(t, err, input)
+		assert.Equal(t, expected, actual, input)
+	}
+
+	for _, input := range []string{"", "K", "-1", "10X"} {
+		_, err := parseSize(input)
+		assert.ErrorContains(t, err, "invalid size", input)
+	}
+}
+
+func TestParseTimeOrAge(t *testing.T) {
+	now := time.Date(2024, 1, 31, 12, 0, 0, 0, time.UTC)
+
+	v, err := parseTimeOrAge("2h", now)
+	require.NoError(t, err)
+	assert.Equal(t, now.Add(-2*time.Hour), v)
+
+	v, err = parseTimeOrAge("7d", now)
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2024, 1, 24, 12, 0, 0, 0, time.UTC), v)
+
+	v, err = parseTimeOrAge("2024-01-01T10:00:00Z", now)
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC), v)
+
+	v, err = parseTimeOrAge("2024-01-01", now)
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2024, 1, 1, 0, 0, 0, 0, time.Local), v)
+
+	_, err = parseTimeOrAge("yesterday", now)
+	assert.ErrorContains(t, err, "invalid time")
+}
+
+func TestFind(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	testutil.WriteFile(t, filepath.Join(dir, "a.csv"), "small")
+	testutil.WriteFile(t, filepath.Join(dir, "sub", "b.csv"), strings.Repeat("x", 2048))
+	testutil.WriteFile(t, filepath.Join(dir, "sub", "c.txt"), "text")
+
+	old := time.Now().Add(-48 * time.Hour)
+	require.NoError(t, os.Chtimes(filepath.Join(dir, "a.csv"), old, old))
+
+	f, err := filer.NewLocalClient("")
+	require.NoError(t, err)
+
+	names := func(p findPredicates) []string {
+		entries, err := find(ctx, f, dir, "root", &p)
+		require.NoError(t, err)
+		var out []string
+		for _, e := range entries {
+			out = append(out, e.Name)
+		}
+		return out
+	}
+
+	all := findPredicates{maxSize: -1, maxDepth: -1}
+	assert.Equal(t, []string{"root/a.csv", "root/sub", "root/sub/b.csv", "root/sub/c.txt"}, names(all))
+
+	p := all
+	p.name = "*.csv"
+	assert.Equal(t, []string{"root/a.csv", "root/sub/b.csv"}, names(p))
+
+	p = all
+	p.kind = "d"
+	assert.Equal(t, []string{"root/sub"}, names(p))
+
+	p = all
+	p.minSize = 1024
+	assert.Equal(t, []string{"root/sub/b.csv"}, names(p))
+
+	p = all
+	p.maxSize = 1024
+	assert.Equal(t, []string{"root/a.csv", "root/sub/c.txt"}, names(p))
+
+	p = all
+	p.older = time.Now().Add(-24 * time.Hour)
+	assert.Equal(t, []string{"root/a.csv"}, names(p))
+
+	p = all
+	p.kind = "f"
+	p.newer = time.Now().Add(-24 * time.Hour)
+	assert.Equal(t, []string{"root/sub/b.csv", "root/sub/c.txt"}, names(p))
+
+	p = all
+	p.maxDepth = 1
+	assert.Equal(t, []string{"root/a.csv", "root/sub"}, names(p))
+}
@@ -8,16 +8,24 @@ func New() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "fs",
 		Short:   "Filesystem related commands",
-		Long:    `Commands to do file system operations on DBFS and UC Volumes.`,
+		Long:    `Commands to do file system operations on DBFS, UC Volumes and workspace files.`,
 		GroupID: "workspace",
 	}
 
 	cmd.AddCommand(
 		newCatCommand(),
 		newCpCommand(),
+		newDuCommand(),
+		newExtractCommand(),
+		newFindCommand(),
 		newLsCommand(),
 		newMkdirCommand(),
+		newMvCommand(),
 		newRmCommand(),
+		newStatCommand(),
+		newSyncCommand(),
+		newTailCommand(),
+		newVerifyCommand(),
 	)
 
 	return cmd
@@ -2,7 +2,10 @@ package fs
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"path"
+	"path/filepath"
 	"runtime"
 	"strings"
 
@@ -31,11 +34,20 @@ func filerForPath(ctx context.Context, fullPath string) (filer.Filer, string, er
 		return f, fullPath, err
 	}
 
-	if parts[0] != "dbfs" {
+	path := parts[1]
+	switch parts[0] {
+	case dbfsScheme:
+		// Handled below.
+	case workspaceScheme, workspaceShortScheme:
+		// Workspace files and notebooks. Notebooks are read and written in their
+		// source format, with the extension that corresponds to their language.
+		w := cmdctx.WorkspaceClient(ctx)
+		f, err := filer.NewWorkspaceFilesExtensionsClient(w, "/")
+		return f, path, err
+	default:
 		return nil, "", fmt.Errorf("invalid scheme: %s", parts[0])
 	}
 
-	path := parts[1]
 	w := cmdctx.WorkspaceClient(ctx)
 
 	// If the specified path has the "Volumes" prefix, use the Files API.
@@ -49,10 +61,57 @@ func filerForPath(ctx context.Context, fullPath string) (filer.Filer, string, er
 	return f, path, err
 }
 
-const dbfsPrefix string = "dbfs:"
+const (
+	dbfsScheme           = "dbfs"
+	workspaceScheme      = "workspace"
+	workspaceShortScheme = "ws"
+)
+
+// schemeForPath returns the scheme of a path, or an empty string for local paths.
+func schemeForPath(path string) string {
+	for _, scheme := range []string{dbfsScheme, workspaceScheme, workspaceShortScheme} {
+		if strings.HasPrefix(path, scheme+":") {
+			return scheme
+		}
+	}
+	return ""
+}
+
+// fullPath returns the path prefixed with its scheme, as specified by the user.
+func fullPath(scheme, p string) string {
+	if scheme == "" {
+		return p
+	}
+	return path.Join(scheme+":", p)
+}
+
+// expandGlob returns the paths that match the path if it contains a glob pattern,
+// or the path itself otherwise. It is an error if no paths match the pattern.
+//
+// If a pattern has no matches or is invalid but exists as a literal path, e.g. "my[1].txt", the path itself is returned.
+func expandGlob(ctx context.Context, f filer.Filer, fullPath, p string) ([]string, error) {
+	if !filer.HasGlob(p) {
+		return []string{p}, nil
+	}
+
+	// Glob patterns always use the "/" separator.
+	if schemeForPath(fullPath) == "" {
+		p = filepath.ToSlash(p)
+	}
 
-func isDbfsPath(path string) bool {
-	return strings.HasPrefix(path, dbfsPrefix)
+	matches, err := filer.Glob(ctx, f, p)
+	if (err == nil && len(matches) == 0) || errors.Is(err, path.ErrBadPattern) {
+		if _, serr := f.Stat(ctx, p); serr == nil {
+			return []string{p}, nil
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(matches) == 0 {
+		return nil, fmt.Errorf("no matches found for %s", fullPath)
+	}
+	return matches, nil
 }
 
 type validArgs struct {
@@ -90,10 +149,9 @@ func (v *validArgs) Validate(cmd *cobra.Command, args []string, toComplete strin
 
 	completer := completer.New(cmd.Context(), filer, v.onlyDirs)
 
-	// Dbfs should have a prefix and always use the "/" separator
-	isDbfsPath := isDbfsPath(toComplete)
-	if isDbfsPath {
-		completer.SetPrefix(dbfsPrefix)
+	// Remote paths should have a prefix and always use the "/" separator
+	if scheme := schemeForPath(toComplete); scheme != "" {
+		completer.SetPrefix(scheme + ":")
 		completer.SetIsLocalPath(false)
 	}
 
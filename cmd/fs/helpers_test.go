@@ -9,6 +9,7 @@ import (
 	"github.com/databricks/cli/libs/cmdctx"
 	"github.com/databricks/cli/libs/fakefs"
 	"github.com/databricks/cli/libs/filer"
+	"github.com/databricks/databricks-sdk-go/config"
 	"github.com/databricks/databricks-sdk-go/experimental/mocks"
 	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/assert"
@@ -41,6 +42,32 @@ func TestFilerForPathForInvalidScheme(t *testing.T) {
 	assert.ErrorContains(t, err, "invalid scheme")
 }
 
+func TestFilerForPathForWorkspacePaths(t *testing.T) {
+	m := mocks.NewMockWorkspaceClient(t)
+	m.WorkspaceClient.Config = &config.Config{
+		Host:  "https://test.cloud.databricks.com",
+		Token: "test-token",
+	}
+	ctx := cmdctx.SetWorkspaceClient(context.Background(), m.WorkspaceClient)
+
+	for _, fullPath := range []string{"ws:/Users/foo/bar.py", "workspace:/Users/foo/bar.py"} {
+		f, path, err := filerForPath(ctx, fullPath)
+		require.NoError(t, err)
+		assert.Equal(t, "/Users/foo/bar.py", path)
+
+		_, ok := f.(*filer.WorkspaceFilesExtensionsClient)
+		assert.True(t, ok)
+	}
+}
+
+func TestSchemeForPath(t *testing.T) {
+	assert.Equal(t, "dbfs", schemeForPath("dbfs:/a"))
+	assert.Equal(t, "ws", schemeForPath("ws:/a"))
+	assert.Equal(t, "workspace", schemeForPath("workspace:/a"))
+	assert.Equal(t, "", schemeForPath("/a"))
+	assert.Equal(t, "", schemeForPath("wsx:/a"))
+}
+
 func testWindowsFilerForPath(t *testing.T, ctx context.Context, fullPath string) {
 	f, path, err := filerForPath(ctx, fullPath)
 	assert.NoError(t, err)
@@ -108,6 +135,21 @@ func TestGetValidArgsFunctionDbfsCompletion(t *testing.T) {
 	assert.Equal(t, cobra.ShellCompDirectiveNoSpace, directive)
 }
 
+func TestGetValidArgsFunctionWorkspaceCompletion(t *testing.T) {
+	v, cmd, _ := setupTest(t)
+	v.filerForPathFunc = func(ctx context.Context, fullPath string) (filer.Filer, string, error) {
+		fakeFiler := filer.NewFakeFiler(map[string]fakefs.FileInfo{
+			"dir":       {FakeName: "root", FakeDir: true},
+			"dir/fileA": {},
+		})
+		return fakeFiler, strings.TrimPrefix(fullPath, "ws:/"), nil
+	}
+
+	completions, directive := v.Validate(cmd, []string{}, "ws:/dir/")
+	assert.Equal(t, []string{"ws:/dir/fileA"}, completions)
+	assert.Equal(t, cobra.ShellCompDirectiveNoSpace, directive)
+}
+
 func TestGetValidArgsFunctionLocalCompletion(t *testing.T) {
 	if runtime.GOOS == "windows" {
 		t.Skip()
@@ -1,6 +1,7 @@
 package fs
 
 import (
+	"context"
 	"io/fs"
 	"path"
 	"sort"
@@ -8,6 +9,7 @@ import (
 
 	"github.com/databricks/cli/cmd/root"
 	"github.com/databricks/cli/libs/cmdio"
+	"github.com/databricks/cli/libs/filer"
 	"github.com/spf13/cobra"
 )
 
@@ -37,11 +39,69 @@ func toJsonDirEntry(f fs.DirEntry, baseDir string, isAbsolute bool) (*jsonDirEnt
 	}, nil
 }
 
+func lsDir(ctx context.Context, f filer.Filer, dir, displayDir string, absolute bool) ([]jsonDirEntry, error) {
+	entries, err := f.ReadDir(ctx, dir)
+	if err != nil {
+		return nil, err
+	}
+
+	jsonDirEntries := make([]jsonDirEntry, len(entries))
+	for i, entry := range entries {
+		jsonDirEntry, err := toJsonDirEntry(entry, displayDir, absolute)
+		if err != nil {
+			return nil, err
+		}
+		jsonDirEntries[i] = *jsonDirEntry
+	}
+	return jsonDirEntries, nil
+}
+
+// lsGlob lists the files that match the pattern and the contents of the directories that match it.
+// Paths are always displayed in full because they may be in different directories.
+func lsGlob(ctx context.Context, f filer.Filer, fullPattern, pattern string) ([]jsonDirEntry, error) {
+	matches, err := expandGlob(ctx, f, fullPattern, pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	scheme := schemeForPath(fullPattern)
+	jsonDirEntries := []jsonDirEntry{}
+	for _, match := range matches {
+		info, err := f.Stat(ctx, match)
+		if err != nil {
+			return nil, err
+		}
+
+		if !info.IsDir() {
+			jsonDirEntries = append(jsonDirEntries, jsonDirEntry{
+				Name:    fullPath(scheme, match),
+				IsDir:   false,
+				Size:    info.Size(),
+				ModTime: info.ModTime(),
+			})
+			continue
+		}
+
+		entries, err := lsDir(ctx, f, match, fullPath(scheme, match), true)
+		if err != nil {
+			return nil, err
+		}
+		jsonDirEntries = append(jsonDirEntries, entries...)
+	}
+	return jsonDirEntries, nil
+}
+
 func newLsCommand() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "ls DIR_PATH",
-		Short:   "Lists files.",
-		Long:    `Lists files in DBFS and UC Volumes.`,
+		Use:   "ls DIR_PATH",
+		Short: "Lists files.",
+		Long: `Lists files in DBFS, UC Volumes and the workspace.
+
+	  DIR_PATH may contain a glob pattern, e.g. dbfs:/logs/2025-*/part-*.json.
+	  Patterns support "*", "?" and "[...]" as in the shell, and "**" to match any
+	  number of directories. Matching files are listed with their full path, as are
+	  the contents of matching directories.
+	`,
 		Args:    root.ExactArgs(1),
 		PreRunE: root.MustWorkspaceClient,
 	}
@@ -59,19 +119,16 @@ func newLsCommand() *cobra.Command {
 			return err
 		}
 
-		entries, err := f.ReadDir(ctx, path)
+		var jsonDirEntries []jsonDirEntry
+		if filer.HasGlob(path) {
+			jsonDirEntries, err = lsGlob(ctx, f, args[0], path)
+		} else {
+			jsonDirEntries, err = lsDir(ctx, f, path, args[0], absolute)
+		}
 		if err != nil {
 			return err
 		}
 
-		jsonDirEntries := make([]jsonDirEntry, len(entries))
-		for i, entry := range entries {
-			jsonDirEntry, err := toJsonDirEntry(entry, args[0], absolute)
-			if err != nil {
-				return err
-			}
-			jsonDirEntries[i] = *jsonDirEntry
-		}
 		sort.Slice(jsonDirEntries, func(i, j int) bool {
 			return jsonDirEntries[i].Name < jsonDirEntries[j].Name
 		})
@@ -0,0 +1,290 @@
+package fs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/databricks/cli/cmd/root"
+	"github.com/databricks/cli/libs/cmdio"
+	"github.com/databricks/cli/libs/filer"
+	"github.com/databricks/cli/libs/log"
+	"github.com/databricks/cli/libs/utils"
+	"github.com/spf13/cobra"
+)
+
+type move struct {
+	overwrite bool
+
+	ctx          context.Context
+	sourceFiler  filer.Filer
+	targetFiler  filer.Filer
+	sourceScheme string
+	targetScheme string
+}
+
+func (m *move) run(sourcePath, targetPath string) error {
+	sourceInfo, err := m.sourceFiler.Stat(m.ctx, sourcePath)
+	if err != nil {
+		return err
+	}
+
+	// If the target path is a directory, move the source into it.
+	targetInfo, err := m.targetFiler.Stat(m.ctx, targetPath)
+	if err == nil && targetInfo.IsDir() {
+		targetPath = path.Join(targetPath, filepath.Base(sourcePath))
+		targetInfo, err = m.targetFiler.Stat(m.ctx, targetPath)
+	}
+
+	// Refuse to move a file or directory onto or into itself.
+	if reflect.TypeOf(m.sourceFiler) == reflect.TypeOf(m.targetFiler) {
+		source, target := cleanPath(sourcePath), cleanPath(targetPath)
+		if source == target {
+			return fmt.Errorf("cannot move %s to itself", fullPath(m.sourceScheme, sourcePath))
+		}
+		if strings.HasPrefix(target, strings.TrimSuffix(source, "/")+"/") {
+			return fmt.Errorf("cannot move %s into itself", fullPath(m.sourceScheme, sourcePath))
+		}
+	}
+
+	// Refuse to replace an existing file or directory unless asked to.
+	if err == nil {
+		if !m.overwrite {
+			return fmt.Errorf("%s already exists. Please specify the --overwrite flag", fullPath(m.targetScheme, targetPath))
+		}
+		if targetInfo.IsDir() != sourceInfo.IsDir() {
+			return fmt.Errorf("cannot overwrite %s with %s", fullPath(m.targetScheme, targetPath), fullPath(m.sourceScheme, sourcePath))
+		}
+		err = m.replace(sourcePath, targetPath, sourceInfo)
+	} else {
+		err = m.move(sourcePath, targetPath, sourceInfo)
+	}
+	if err != nil {
+		return err
+	}
+
+	event := newFileMovedEvent(fullPath(m.sourceScheme, sourcePath), fullPath(m.targetScheme, targetPath))
+	return cmdio.RenderWithTemplate(m.ctx, event, "", "{{.SourcePath}} -> {{.TargetPath}}\n")
+}
+
+// cleanPath returns the absolute, cleaned form of a path, to compare paths on the same file system.
+func cleanPath(p string) string {
+	p = filepath.ToSlash(p)
+	if !path.IsAbs(p) {
+		if abs, err := filepath.Abs(p); err == nil {
+			p = filepath.ToSlash(abs)
+		}
+	}
+	return path.Clean(p)
+}
+
+// move moves the source to a target that does not exist.
+func (m *move) move(sourcePath, targetPath string, info fs.FileInfo) error {
+	renamed, err := m.rename(sourcePath, targetPath)
+	if err != nil || renamed {
+		return err
+	}
+	err = m.copyTo(sourcePath, targetPath, info, false)
+	if err != nil {
+		return err
+	}
+	return m.sourceFiler.Delete(m.ctx, sourcePath, filer.DeleteRecursively)
+}
+
+// replace moves the source over an existing target. The target is only removed
+// once the source is in place, so it is kept if the move fails.
+func (m *move) replace(sourcePath, targetPath string, info fs.FileInfo) error {
+	renamer, ok := m.targetFiler.(filer.Renamer)
+	if !ok {
+		return m.copyOver(sourcePath, targetPath, info)
+	}
+
+	// Move the target aside, move the source in its place and only then delete the old target.
+	backupPath := path.Join(path.Dir(targetPath), fmt.Sprintf(".%s.mv-%d", path.Base(targetPath), time.Now().UnixNano()))
+	err := renamer.Rename(m.ctx, targetPath, backupPath)
+	if errors.Is(err, errors.ErrUnsupported) {
+		return m.copyOver(sourcePath, targetPath, info)
+	}
+	if err != nil {
+		return err
+	}
+
+	renamed, err := m.rename(sourcePath, targetPath)
+	if err == nil && !renamed {
+		err = m.copyTo(sourcePath, targetPath, info, false)
+	}
+	if err != nil {
+		// Remove what was moved so far and restore the original target.
+		_ = m.targetFiler.Delete(m.ctx, targetPath, filer.DeleteRecursively)
+		rerr := renamer.Rename(m.ctx, backupPath, targetPath)
+		if rerr != nil {
+			return fmt.Errorf("%w (the original %s was kept at %s)", err, fullPath(m.targetScheme, targetPath), fullPath(m.targetScheme, backupPath))
+		}
+		return err
+	}
+
+	err = m.targetFiler.Delete(m.ctx, backupPath, filer.DeleteRecursively)
+	if err != nil || renamed {
+		return err
+	}
+	return m.sourceFiler.Delete(m.ctx, sourcePath, filer.DeleteRecursively)
+}
+
+// copyOver copies the source over the target and then deletes the source.
+// Files in the target are overwritten once their new content is written.
+func (m *move) copyOver(sourcePath, targetPath string, info fs.FileInfo) error {
+	err := m.copyTo(sourcePath, targetPath, info, true)
+	if err != nil {
+		return err
+	}
+	return m.sourceFiler.Delete(m.ctx, sourcePath, filer.DeleteRecursively)
+}
+
+// rename renames the source to the target with a single operation if the filer supports it.
+// Returns false if the source must be copied and deleted instead.
+func (m *move) rename(sourcePath, targetPath string) (bool, error) {
+	// Both paths must be accessed through the same kind of filer.
+	renamer, ok := m.sourceFiler.(filer.Renamer)
+	if !ok || reflect.TypeOf(m.sourceFiler) != reflect.TypeOf(m.targetFiler) {
+		return false, nil
+	}
+
+	err := renamer.Rename(m.ctx, sourcePath, targetPath)
+	if errors.Is(err, errors.ErrUnsupported) {
+		log.Debugf(m.ctx, "Falling back to copy and delete: %s", err)
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// copyTo copies the source to the target. If prune is set, files and directories in the target directory that don't exist
+// in the source directory are deleted after the copy, so the target matches the source.
+func (m *move) copyTo(sourcePath, targetPath string, info fs.FileInfo, prune bool) error {
+	c := copy{
+		overwrite:   true,
+		recursive:   true,
+		concurrency: 10,
+		quiet:       true,
+
+		ctx:          m.ctx,
+		sourceFiler:  m.sourceFiler,
+		targetFiler:  m.targetFiler,
+		sourceScheme: m.sourceScheme,
+		targetScheme: m.targetScheme,
+	}
+
+	var err error
+	if info.IsDir() {
+		err = c.cpDirToDir(sourcePath, targetPath)
+		if err == nil && prune {
+			err = m.prune(sourcePath, targetPath)
+		}
+	} else {
+		c.progress = newProgress(1, info.Size())
+		stop := c.progress.show(m.ctx)
+		err = c.cpFileToFile(m.ctx, sourcePath, targetPath)
+		stop()
+	}
+	return err
+}
+
+// prune deletes the files and directories in the target directory that don't exist in the source directory.
+func (m *move) prune(sourceDir, targetDir string) error {
+	source, err := listTree(m.ctx, m.sourceFiler, sourceDir, selectAll)
+	if err != nil {
+		return err
+	}
+	target, err := listTree(m.ctx, m.targetFiler, targetDir, selectAll)
+	if err != nil {
+		return err
+	}
+
+	for _, relPath := range utils.SortedKeys(target.files) {
+		if _, ok := source.files[relPath]; ok {
+			continue
+		}
+		err = m.targetFiler.Delete(m.ctx, path.Join(targetDir, relPath))
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return err
+		}
+	}
+
+	// Delete directories in reverse order, so nested directories go first.
+	dirs := utils.SortedKeys(target.dirs)
+	slices.Reverse(dirs)
+	for _, relPath := range dirs {
+		if source.dirs[relPath] {
+			continue
+		}
+		err = m.targetFiler.Delete(m.ctx, path.Join(targetDir, relPath), filer.DeleteRecursively)
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return err
+		}
+	}
+	return nil
+}
+
+func newMvCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mv SOURCE_PATH TARGET_PATH",
+		Short: "Move files and directories.",
+		Long: `Move files and directories to and from any paths on DBFS, UC Volumes, the workspace or your local filesystem.
+
+	  For paths in DBFS and UC Volumes, it is required that you specify the "dbfs" scheme.
+	  For example: dbfs:/foo/bar.
+
+	  For workspace files and notebooks, specify the "ws" or "workspace" scheme.
+	  For example: ws:/Users/someone@example.com/project. Notebooks are read and
+	  written in their source format, e.g. notebook.py for a Python notebook.
+
+	  If TARGET_PATH is a directory, SOURCE_PATH is moved inside the directory.
+
+	  Files and directories are renamed without copying their contents if both
+	  paths are on the local filesystem or on DBFS. Otherwise they are copied
+	  to TARGET_PATH and deleted from SOURCE_PATH.
+
+	  With --overwrite, an existing TARGET_PATH is only removed once SOURCE_PATH
+	  is in its place, so it is kept if the move fails.
+	`,
+		Args:    root.ExactArgs(2),
+		PreRunE: root.MustWorkspaceClient,
+	}
+
+	var m move
+	cmd.Flags().BoolVar(&m.overwrite, "overwrite", false, "overwrite existing files and directories")
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+
+		sourceFiler, sourcePath, err := filerForPath(ctx, args[0])
+		if err != nil {
+			return err
+		}
+
+		targetFiler, targetPath, err := filerForPath(ctx, args[1])
+		if err != nil {
+			return err
+		}
+
+		m.sourceScheme = schemeForPath(args[0])
+		m.targetScheme = schemeForPath(args[1])
+
+		m.ctx = ctx
+		m.sourceFiler = sourceFiler
+		m.targetFiler = targetFiler
+		return m.run(sourcePath, targetPath)
+	}
+
+	v := newValidArgs()
+	v.pathArgCount = 2
+	cmd.ValidArgsFunction = v.Validate
+
+	return cmd
+}
@@ -0,0 +1,111 @@
+package fs
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/databricks/cli/internal/testutil"
+	"github.com/databricks/cli/libs/cmdio"
+	"github.com/databricks/cli/libs/filer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// noRenameFiler hides the [filer.Renamer] implementation of the wrapped filer.
+type noRenameFiler struct {
+	filer.Filer
+}
+
+func newTestMove(t *testing.T, rename bool) *move {
+	f, err := filer.NewLocalClient("")
+	require.NoError(t, err)
+	if !rename {
+		f = noRenameFiler{f}
+	}
+
+	return &move{
+		ctx:         cmdio.MockDiscard(context.Background()),
+		sourceFiler: f,
+		targetFiler: f,
+	}
+}
+
+func TestMoveFile(t *testing.T) {
+	for _, rename := range []bool{true, false} {
+		dir := t.TempDir()
+		testutil.WriteFile(t, filepath.Join(dir, "a.txt"), "a")
+		testutil.WriteFile(t, filepath.Join(dir, "b.txt"), "b")
+		testutil.Touch(t, dir, "target", "c.txt")
+
+		m := newTestMove(t, rename)
+		require.NoError(t, m.run(filepath.Join(dir, "a.txt"), filepath.Join(dir, "renamed.txt")))
+		assert.NoFileExists(t, filepath.Join(dir, "a.txt"))
+		assert.Equal(t, "a", testutil.ReadFile(t, filepath.Join(dir, "renamed.txt")))
+
+		// Files are moved into an existing directory.
+		require.NoError(t, m.run(filepath.Join(dir, "b.txt"), filepath.Join(dir, "target")))
+		assert.Equal(t, "b", testutil.ReadFile(t, filepath.Join(dir, "target", "b.txt")))
+
+		// Existing files are only replaced with --overwrite.
+		err := m.run(filepath.Join(dir, "renamed.txt"), filepath.Join(dir, "target", "c.txt"))
+		assert.ErrorContains(t, err, "already exists")
+
+		m.overwrite = true
+		require.NoError(t, m.run(filepath.Join(dir, "renamed.txt"), filepath.Join(dir, "target", "c.txt")))
+		assert.Equal(t, "a", testutil.ReadFile(t, filepath.Join(dir, "target", "c.txt")))
+	}
+}
+
+func TestMoveDirectory(t *testing.T) {
+	for _, rename := range []bool{true, false} {
+		dir := t.TempDir()
+		testutil.WriteFile(t, filepath.Join(dir, "src", "a.txt"), "a")
+		testutil.WriteFile(t, filepath.Join(dir, "src", "sub", "b.txt"), "b")
+
+		m := newTestMove(t, rename)
+		require.NoError(t, m.run(filepath.Join(dir, "src"), filepath.Join(dir, "dst")))
+		assert.NoDirExists(t, filepath.Join(dir, "src"))
+		assert.Equal(t, "a", testutil.ReadFile(t, filepath.Join(dir, "dst", "a.txt")))
+		assert.Equal(t, "b", testutil.ReadFile(t, filepath.Join(dir, "dst", "sub", "b.txt")))
+	}
+}
+
+func TestMoveOverwriteReplacesTarget(t *testing.T) {
+	for _, rename := range []bool{true, false} {
+		dir := t.TempDir()
+		testutil.WriteFile(t, filepath.Join(dir, "src", "a.txt"), "new")
+		testutil.WriteFile(t, filepath.Join(dir, "target", "src", "a.txt"), "old")
+		testutil.WriteFile(t, filepath.Join(dir, "target", "src", "stale", "b.txt"), "old")
+
+		m := newTestMove(t, rename)
+		m.overwrite = true
+		require.NoError(t, m.run(filepath.Join(dir, "src"), filepath.Join(dir, "target")))
+		assert.NoDirExists(t, filepath.Join(dir, "src"))
+		assert.Equal(t, "new", testutil.ReadFile(t, filepath.Join(dir, "target", "src", "a.txt")))
+		assert.NoDirExists(t, filepath.Join(dir, "target", "src", "stale"))
+
+		// No backup of the old target is left behind.
+		entries, err := os.ReadDir(filepath.Join(dir, "target"))
+		require.NoError(t, err)
+		assert.Len(t, entries, 1)
+	}
+}
+
+func TestMoveToItself(t *testing.T) {
+	dir := t.TempDir()
+	testutil.WriteFile(t, filepath.Join(dir, "d", "a.txt"), "a")
+
+	m := newTestMove(t, true)
+	m.overwrite = true
+
+	// The target resolves to the source because the source is inside the target directory.
+	err := m.run(filepath.Join(dir, "d", "a.txt"), filepath.Join(dir, "d"))
+	assert.ErrorContains(t, err, "to itself")
+	assert.Equal(t, "a", testutil.ReadFile(t, filepath.Join(dir, "d", "a.txt")))
+
+	err = m.run(filepath.Join(dir, "d"), filepath.Join(dir, "d", "sub"))
+	assert.ErrorContains(t, err, "into itself")
+	assert.FileExists(t, filepath.Join(dir, "d", "a.txt"))
+}
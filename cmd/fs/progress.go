@@ -0,0 +1,117 @@
+package fs
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync/atomic"
+	"time"
+
+	"github.com/databricks/cli/libs/cmdio"
+)
+
+// progress tracks the number of files and bytes transferred by a command
+// and shows it on stderr if the terminal is interactive.
+type progress struct {
+	totalFiles int64
+	totalBytes int64
+
+	files atomic.Int64
+	bytes atomic.Int64
+
+	start time.Time
+	now   func() time.Time
+}
+
+func newProgress(totalFiles, totalBytes int64) *progress {
+	return &progress{
+		totalFiles: totalFiles,
+		totalBytes: totalBytes,
+		start:      time.Now(),
+		now:        time.Now,
+	}
+}
+
+// reader returns a reader that counts the bytes read from r as transferred.
+func (p *progress) reader(r io.Reader) io.Reader {
+	return &progressReader{r: r, p: p}
+}
+
+func (p *progress) fileDone() {
+	p.files.Add(1)
+}
+
+func (p *progress) String() string {
+	files := p.files.Load()
+	bytes := p.bytes.Load()
+
+	s := fmt.Sprintf("%d/%d files, %s/%s", files, p.totalFiles, formatBytes(bytes), formatBytes(p.totalBytes))
+
+	elapsed := p.now().Sub(p.start).Seconds()
+	if elapsed <= 0 || bytes == 0 {
+		return s
+	}
+
+	rate := float64(bytes) / elapsed
+	s += fmt.Sprintf(" (%s/s", formatBytes(int64(rate)))
+	if remaining := p.totalBytes - bytes; remaining > 0 {
+		eta := time.Duration(float64(remaining) / rate * float64(time.Second))
+		s += ", ETA " + eta.Round(time.Second).String()
+	}
+	return s + ")"
+}
+
+// show updates the spinner with the progress until the returned function is called.
+func (p *progress) show(ctx context.Context) func() {
+	ctx, cancel := context.WithCancel(ctx)
+	updates := cmdio.Spinner(ctx)
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+		ticker := time.NewTicker(200 * time.Millisecond)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				select {
+				case updates <- p.String():
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return func() {
+		cancel()
+		<-done
+	}
+}
+
+type progressReader struct {
+	r io.Reader
+	p *progress
+}
+
+func (r *progressReader) Read(b []byte) (int, error) {
+	n, err := r.r.Read(b)
+	r.p.bytes.Add(int64(n))
+	return n, err
+}
+
+// formatBytes formats a number of bytes in a human readable form, e.g. 1.5 MiB.
+func formatBytes(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+	div, exp := int64(unit), 0
+	for m := n / unit; m >= unit; m /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(n)/float64(div), "KMGTPE"[exp])
+}
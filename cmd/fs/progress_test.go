@@ -0,0 +1,32 @@
+package fs
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatBytes(t *testing.T) {
+	assert.Equal(t, "0 B", formatBytes(0))
+	assert.Equal(t, "1023 B", formatBytes(1023))
+	assert.Equal(t, "1.0 KiB", formatBytes(1024))
+	assert.Equal(t, "1.5 MiB", formatBytes(3*512*1024))
+	assert.Equal(t, "2.0 GiB", formatBytes(2*1024*1024*1024))
+}
+
+func TestProgressString(t *testing.T) {
+	p := newProgress(4, 4*1024*1024)
+	now := p.start
+	p.now = func() time.Time { return now }
+
+	assert.Equal(t, "0/4 files, 0 B/4.0 MiB", p.String())
+
+	// Transfer 1 MiB in 2 seconds.
+	_, err := p.reader(strings.NewReader(strings.Repeat("x", 1024*1024))).Read(make([]byte, 1024*1024))
+	assert.NoError(t, err)
+	p.fileDone()
+	now = now.Add(2 * time.Second)
+	assert.Equal(t, "1/4 files, 1.0 MiB/4.0 MiB (512.0 KiB/s, ETA 6s)", p.String())
+}
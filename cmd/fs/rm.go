@@ -1,22 +1,37 @@
 package fs
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"slices"
+
 	"github.com/databricks/cli/cmd/root"
+	"github.com/databricks/cli/libs/cmdio"
 	"github.com/databricks/cli/libs/filer"
 	"github.com/spf13/cobra"
 )
 
 func newRmCommand() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "rm PATH",
-		Short:   "Remove files and directories.",
-		Long:    `Remove files and directories from DBFS and UC Volumes.`,
+		Use:   "rm PATH",
+		Short: "Remove files and directories.",
+		Long: `Remove files and directories from DBFS, UC Volumes and the workspace.
+
+	  PATH may contain a glob pattern, e.g. dbfs:/Volumes/main/default/tmp/*.parquet.
+	  Patterns support "*", "?" and "[...]" as in the shell, and "**" to match any
+	  number of directories. Use --dry-run to show the paths that would be removed.
+	`,
 		Args:    root.ExactArgs(1),
 		PreRunE: root.MustWorkspaceClient,
 	}
 
 	var recursive bool
+	var dryRun bool
 	cmd.Flags().BoolVarP(&recursive, "recursive", "r", false, "Recursively delete a non-empty directory.")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Show the paths that would be removed without removing them.")
 
 	cmd.RunE = func(cmd *cobra.Command, args []string) error {
 		ctx := cmd.Context()
@@ -26,10 +41,44 @@ func newRmCommand() *cobra.Command {
 			return err
 		}
 
-		if recursive {
-			return f.Delete(ctx, path, filer.DeleteRecursively)
+		paths, err := expandGlob(ctx, f, args[0], path)
+		if err != nil {
+			return err
 		}
-		return f.Delete(ctx, path)
+
+		// Remove the contents of directories before the directories themselves.
+		slices.Reverse(paths)
+
+		scheme := schemeForPath(args[0])
+		removed := make(map[string]bool)
+		for _, p := range paths {
+			switch {
+			case dryRun:
+				err = dryRunRemove(ctx, f, p, recursive, removed)
+			case recursive:
+				err = f.Delete(ctx, p, filer.DeleteRecursively)
+			default:
+				err = f.Delete(ctx, p)
+			}
+
+			// A match may have been removed together with its parent directory.
+			if len(paths) > 1 && errors.Is(err, fs.ErrNotExist) {
+				continue
+			}
+			if err != nil {
+				return err
+			}
+
+			if dryRun {
+				event := newFileDeletedEvent(fullPath(scheme, p))
+				event.DryRun = true
+				err = cmdio.RenderWithTemplate(ctx, event, "", "(dry run) deleted {{.TargetPath}}\n")
+				if err != nil {
+					return err
+				}
+			}
+		}
+		return nil
 	}
 
 	v := newValidArgs()
@@ -37,3 +86,33 @@ func newRmCommand() *cobra.Command {
 
 	return cmd
 }
+
+// dryRunRemove checks that the path could be removed in the same way as a real run,
+// given the paths in removed that a real run would have removed before it.
+func dryRunRemove(ctx context.Context, f filer.Filer, p string, recursive bool, removed map[string]bool) error {
+	for child, dir := p, path.Dir(p); dir != child; child, dir = dir, path.Dir(dir) {
+		if removed[dir] {
+			return fs.ErrNotExist
+		}
+	}
+
+	info, err := f.Stat(ctx, p)
+	if err != nil {
+		return err
+	}
+
+	if info.IsDir() && !recursive {
+		entries, err := f.ReadDir(ctx, p)
+		if err != nil {
+			return err
+		}
+		for _, entry := range entries {
+			if !removed[path.Join(p, entry.Name())] {
+				return fmt.Errorf("directory not empty: %s", p)
+			}
+		}
+	}
+
+	removed[p] = true
+	return nil
+}
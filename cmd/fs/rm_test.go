@@ -0,0 +1,40 @@
+package fs
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/databricks/cli/internal/testutil"
+	"github.com/databricks/cli/libs/cmdio"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func runRmDryRun(t *testing.T, recursive bool, pattern string) error {
+	cmd := newRmCommand()
+	cmd.SetContext(cmdio.MockDiscard(context.Background()))
+	require.NoError(t, cmd.Flags().Set("dry-run", "true"))
+	if recursive {
+		require.NoError(t, cmd.Flags().Set("recursive", "true"))
+	}
+	return cmd.RunE(cmd, []string{pattern})
+}
+
+func TestRmGlobDryRun(t *testing.T) {
+	dir := filepath.ToSlash(t.TempDir())
+	testutil.WriteFile(t, filepath.Join(dir, "a.txt"), "a")
+	testutil.WriteFile(t, filepath.Join(dir, "sub", "b.txt"), "b")
+
+	// A non-empty directory is only removed with --recursive, as in a real run.
+	err := runRmDryRun(t, false, dir+"/*")
+	assert.ErrorContains(t, err, "directory not empty: "+dir+"/sub")
+	assert.NoError(t, runRmDryRun(t, true, dir+"/*"))
+
+	// The directory is empty once the matches in it have been removed.
+	assert.NoError(t, runRmDryRun(t, false, dir+"/**"))
+
+	// Nothing is removed.
+	assert.FileExists(t, filepath.Join(dir, "a.txt"))
+	assert.FileExists(t, filepath.Join(dir, "sub", "b.txt"))
+}
@@ -0,0 +1,50 @@
+package fs
+
+import (
+	"github.com/databricks/cli/cmd/root"
+	"github.com/databricks/cli/libs/cmdio"
+	"github.com/spf13/cobra"
+)
+
+func newStatCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "stat PATH",
+		Short:   "Show file or directory information.",
+		Long:    `Show the type, size and modification time of a file or directory in DBFS, a UC Volume, the workspace or your local filesystem.`,
+		Args:    root.ExactArgs(1),
+		PreRunE: root.MustWorkspaceClient,
+	}
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+
+		f, path, err := filerForPath(ctx, args[0])
+		if err != nil {
+			return err
+		}
+
+		info, err := f.Stat(ctx, path)
+		if err != nil {
+			return err
+		}
+
+		entry := jsonDirEntry{
+			Name:    args[0],
+			IsDir:   info.IsDir(),
+			Size:    info.Size(),
+			ModTime: info.ModTime(),
+		}
+
+		return cmdio.RenderWithTemplate(ctx, entry, "", cmdio.Heredoc(`
+		Path:          {{.Name}}
+		Type:          {{if .IsDir}}directory{{else}}file{{end}}
+		Size:          {{.Size}}
+		Last modified: {{.ModTime|pretty_date}}
+		`))
+	}
+
+	v := newValidArgs()
+	cmd.ValidArgsFunction = v.Validate
+
+	return cmd
+}
@@ -0,0 +1,389 @@
+package fs
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/databricks/cli/cmd/root"
+	"github.com/databricks/cli/libs/cmdio"
+	"github.com/databricks/cli/libs/filer"
+	"github.com/databricks/cli/libs/log"
+	"github.com/databricks/cli/libs/utils"
+	ignore "github.com/sabhiram/go-gitignore"
+	"github.com/spf13/cobra"
+	"golang.org/x/sync/errgroup"
+)
+
+type mirror struct {
+	delete      bool
+	dryRun      bool
+	checksum    bool
+	concurrency int
+	include     []string
+	exclude     []string
+
+	ctx          context.Context
+	sourceFiler  filer.Filer
+	targetFiler  filer.Filer
+	sourceScheme string
+	targetScheme string
+
+	includeMatcher *ignore.GitIgnore
+	excludeMatcher *ignore.GitIgnore
+
+	// Guards output because files are transferred in parallel.
+	mu sync.Mutex
+}
+
+// tree is the result of listing a directory recursively.
+// Paths are relative to the listed directory and slash-separated.
+type tree struct {
+	files map[string]fs.FileInfo
+	dirs  map[string]bool
+}
+
+func (m *mirror) list(f filer.Filer, dir string) (*tree, error) {
+	t, err := listTree(m.ctx, f, dir, m.selected)
+
+	// A target directory that does not exist yet is empty.
+	if errors.Is(err, fs.ErrNotExist) && f == m.targetFiler {
+		return t, nil
+	}
+	return t, err
+}
+
+// listTree lists the directory recursively. Only the files and directories for which selected returns true are included;
+// directories for which it returns false are not walked.
+func listTree(ctx context.Context, f filer.Filer, dir string, selected func(relPath string, isDir bool) bool) (*tree, error) {
+	t := &tree{
+		files: make(map[string]fs.FileInfo),
+		dirs:  make(map[string]bool),
+	}
+
+	err := fs.WalkDir(filer.NewFS(ctx, f), dir, func(name string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		relPath, err := filepath.Rel(dir, name)
+		if err != nil {
+			return err
+		}
+		relPath = filepath.ToSlash(relPath)
+		if relPath == "." {
+			return nil
+		}
+
+		if !selected(relPath, d.IsDir()) {
+			if d.IsDir() {
+				return fs.SkipDir
+			}
+			return nil
+		}
+
+		if d.IsDir() {
+			t.dirs[relPath] = true
+			return nil
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		t.files[relPath] = info
+		return nil
+	})
+	return t, err
+}
+
+// selectAll is the selection function of listTree that includes all files and directories.
+func selectAll(string, bool) bool {
+	return true
+}
+
+// selected returns true if the file at the relative path matches the include and exclude patterns.
+// Only the exclude patterns apply to directories, because files below an excluded directory are excluded
+// as well, while files below a directory that doesn't match the include patterns may still match them.
+func (m *mirror) selected(relPath string, isDir bool) bool {
+	if isDir {
+		// Patterns with a trailing slash, such as "node_modules/", only match directories.
+		return m.excludeMatcher == nil || !m.excludeMatcher.MatchesPath(relPath+"/")
+	}
+	if m.includeMatcher != nil && !m.includeMatcher.MatchesPath(relPath) {
+		return false
+	}
+	if m.excludeMatcher != nil && m.excludeMatcher.MatchesPath(relPath) {
+		return false
+	}
+	return true
+}
+
+// changed returns true if the source file has to be copied to the target.
+func (m *mirror) changed(sourcePath, targetPath string, source, target fs.FileInfo) (bool, error) {
+	if target == nil || source.Size() != target.Size() {
+		return true, nil
+	}
+
+	if !m.checksum {
+		return source.ModTime().After(target.ModTime()), nil
+	}
+
+	sourceHash, err := hashFile(m.ctx, m.sourceFiler, sourcePath)
+	if err != nil {
+		return false, err
+	}
+	targetHash, err := hashFile(m.ctx, m.targetFiler, targetPath)
+	if err != nil {
+		return false, err
+	}
+	return sourceHash != targetHash, nil
+}
+
+// hashFile returns the SHA-256 hash of the file, streaming its content.
+func hashFile(ctx context.Context, f filer.Filer, name string) (string, error) {
+	r, err := f.Read(ctx, name)
+	if err != nil {
+		return "", err
+	}
+	defer r.Close()
+
+	h := sha256.New()
+	_, err = io.Copy(h, r)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%x", h.Sum(nil)), nil
+}
+
+func (m *mirror) run(sourceDir, targetDir string) error {
+	source, err := m.list(m.sourceFiler, sourceDir)
+	if err != nil {
+		return err
+	}
+
+	target, err := m.list(m.targetFiler, targetDir)
+	if err != nil {
+		return err
+	}
+
+	// Determine the files to copy. Comparing content may read files, so it runs in parallel too.
+	g, ctx := errgroup.WithContext(m.ctx)
+	g.SetLimit(m.concurrency)
+
+	for _, relPath := range utils.SortedKeys(source.files) {
+		g.Go(func() error {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+
+			sourcePath := path.Join(sourceDir, relPath)
+			targetPath := path.Join(targetDir, relPath)
+			ok, err := m.changed(sourcePath, targetPath, source.files[relPath], target.files[relPath])
+			if err != nil || !ok {
+				return err
+			}
+
+			return m.copyFile(sourcePath, targetPath)
+		})
+	}
+
+	err = g.Wait()
+	if err != nil {
+		return err
+	}
+
+	// Create empty directories so that the target has the same structure.
+	for _, relPath := range utils.SortedKeys(source.dirs) {
+		if target.dirs[relPath] || m.dryRun {
+			continue
+		}
+		err = m.targetFiler.Mkdir(m.ctx, path.Join(targetDir, relPath))
+		if err != nil {
+			return err
+		}
+	}
+
+	if !m.delete {
+		return nil
+	}
+
+	return m.deleteExtraneous(source, target, targetDir)
+}
+
+// deleteExtraneous deletes files and directories in the target that don't exist in the source.
+// Files that don't match the include and exclude patterns are kept, as are the directories containing them.
+func (m *mirror) deleteExtraneous(source, target *tree, targetDir string) error {
+	g, ctx := errgroup.WithContext(m.ctx)
+	g.SetLimit(m.concurrency)
+
+	for _, relPath := range utils.SortedKeys(target.files) {
+		if _, ok := source.files[relPath]; ok {
+			continue
+		}
+		g.Go(func() error {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			return m.deleteFile(path.Join(targetDir, relPath))
+		})
+	}
+
+	err := g.Wait()
+	if err != nil {
+		return err
+	}
+
+	// Delete directories from the deepest to the top-most one.
+	var dirs []string
+	for relPath := range target.dirs {
+		if !source.dirs[relPath] {
+			dirs = append(dirs, relPath)
+		}
+	}
+	slices.SortFunc(dirs, func(a, b string) int {
+		return strings.Count(b, "/") - strings.Count(a, "/")
+	})
+
+	for _, relPath := range dirs {
+		if m.dryRun {
+			continue
+		}
+		err = m.targetFiler.Delete(m.ctx, path.Join(targetDir, relPath))
+		if err != nil {
+			// The directory is not empty if it contains excluded files.
+			log.Debugf(m.ctx, "cannot delete directory %s: %s", relPath, err)
+		}
+	}
+
+	return nil
+}
+
+func (m *mirror) copyFile(sourcePath, targetPath string) error {
+	if !m.dryRun {
+		r, err := m.sourceFiler.Read(m.ctx, sourcePath)
+		if err != nil {
+			return err
+		}
+		defer r.Close()
+
+		err = m.targetFiler.Write(m.ctx, targetPath, r, filer.OverwriteIfExists, filer.CreateParentDirectories)
+		if err != nil {
+			return err
+		}
+	}
+
+	event := newFileCopiedEvent(fullPath(m.sourceScheme, sourcePath), fullPath(m.targetScheme, targetPath))
+	event.DryRun = m.dryRun
+	return m.render(event, "{{if .DryRun}}(dry run) {{end}}{{.SourcePath}} -> {{.TargetPath}}\n")
+}
+
+func (m *mirror) deleteFile(targetPath string) error {
+	if !m.dryRun {
+		err := m.targetFiler.Delete(m.ctx, targetPath)
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return err
+		}
+	}
+
+	event := newFileDeletedEvent(fullPath(m.targetScheme, targetPath))
+	event.DryRun = m.dryRun
+	return m.render(event, "{{if .DryRun}}(dry run) {{end}}deleted {{.TargetPath}}\n")
+}
+
+func (m *mirror) render(event fileIOEvent, template string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return cmdio.RenderWithTemplate(m.ctx, event, "", template)
+}
+
+func newSyncCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sync SOURCE_DIR TARGET_DIR",
+		Short: "Mirror a directory.",
+		Long: `Mirror a directory to and from any paths on DBFS, UC Volumes, the workspace or your local filesystem.
+
+	  For paths in DBFS and UC Volumes, it is required that you specify the "dbfs" scheme.
+	  For example: dbfs:/Volumes/main/default/data.
+
+	  For workspace files and notebooks, specify the "ws" or "workspace" scheme.
+	  For example: ws:/Users/someone@example.com/project. Notebooks are read and
+	  written in their source format, e.g. notebook.py for a Python notebook.
+
+	  Only files that are missing in TARGET_DIR, have a different size, or were
+	  modified more recently in SOURCE_DIR are copied. With --checksum, files of
+	  the same size are compared by their content instead of their modification time.
+
+	  With --delete, files in TARGET_DIR that don't exist in SOURCE_DIR are deleted.
+	  Files that don't match the --include and --exclude patterns are never copied
+	  or deleted. Excluded directories, e.g. --exclude node_modules/, are skipped
+	  entirely. Patterns use the .gitignore syntax.
+	`,
+		Args:    root.ExactArgs(2),
+		PreRunE: root.MustWorkspaceClient,
+	}
+
+	m := mirror{}
+	cmd.Flags().BoolVar(&m.delete, "delete", false, "delete files in the target directory that don't exist in the source directory")
+	cmd.Flags().BoolVar(&m.dryRun, "dry-run", false, "show what would be copied and deleted without making changes")
+	cmd.Flags().BoolVar(&m.checksum, "checksum", false, "compare files by their content instead of their modification time")
+	cmd.Flags().IntVar(&m.concurrency, "concurrency", 10, "number of files to transfer in parallel")
+	cmd.Flags().StringSliceVar(&m.include, "include", nil, "patterns of files to include (can be specified multiple times)")
+	cmd.Flags().StringSliceVar(&m.exclude, "exclude", nil, "patterns of files to exclude (can be specified multiple times)")
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+
+		if m.concurrency < 1 {
+			return errors.New("--concurrency must be at least 1")
+		}
+
+		sourceFiler, sourcePath, err := filerForPath(ctx, args[0])
+		if err != nil {
+			return err
+		}
+
+		targetFiler, targetPath, err := filerForPath(ctx, args[1])
+		if err != nil {
+			return err
+		}
+
+		m.sourceScheme = schemeForPath(args[0])
+		m.targetScheme = schemeForPath(args[1])
+
+		m.ctx = ctx
+		m.sourceFiler = sourceFiler
+		m.targetFiler = targetFiler
+		if len(m.include) > 0 {
+			m.includeMatcher = ignore.CompileIgnoreLines(m.include...)
+		}
+		if len(m.exclude) > 0 {
+			m.excludeMatcher = ignore.CompileIgnoreLines(m.exclude...)
+		}
+
+		sourceInfo, err := sourceFiler.Stat(ctx, sourcePath)
+		if err != nil {
+			return err
+		}
+		if !sourceInfo.IsDir() {
+			return fmt.Errorf("source path %s is not a directory", args[0])
+		}
+
+		return m.run(sourcePath, targetPath)
+	}
+
+	v := newValidArgs()
+	v.pathArgCount = 2
+	v.onlyDirs = true
+	cmd.ValidArgsFunction = v.Validate
+
+	return cmd
+}
@@ -0,0 +1,124 @@
+package fs
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/databricks/cli/internal/testutil"
+	"github.com/databricks/cli/libs/cmdio"
+	"github.com/databricks/cli/libs/filer"
+	ignore "github.com/sabhiram/go-gitignore"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestMirror(t *testing.T) *mirror {
+	f, err := filer.NewLocalClient("")
+	require.NoError(t, err)
+
+	return &mirror{
+		concurrency: 2,
+		ctx:         cmdio.MockDiscard(context.Background()),
+		sourceFiler: f,
+		targetFiler: f,
+	}
+}
+
+func TestMirrorCopiesChangedFiles(t *testing.T) {
+	source := t.TempDir()
+	target := filepath.Join(t.TempDir(), "target")
+
+	testutil.WriteFile(t, filepath.Join(source, "a.txt"), "a")
+	testutil.WriteFile(t, filepath.Join(source, "dir", "b.txt"), "b")
+	require.NoError(t, os.MkdirAll(filepath.Join(source, "empty"), 0o755))
+
+	m := newTestMirror(t)
+	require.NoError(t, m.run(source, target))
+	assert.Equal(t, "a", testutil.ReadFile(t, filepath.Join(target, "a.txt")))
+	assert.Equal(t, "b", testutil.ReadFile(t, filepath.Join(target, "dir", "b.txt")))
+	assert.DirExists(t, filepath.Join(target, "empty"))
+
+	// A target file that is newer than the source file and has the same size is not copied.
+	testutil.WriteFile(t, filepath.Join(target, "a.txt"), "x")
+	require.NoError(t, m.run(source, target))
+	assert.Equal(t, "x", testutil.ReadFile(t, filepath.Join(target, "a.txt")))
+
+	// Unless its content is compared.
+	m.checksum = true
+	require.NoError(t, m.run(source, target))
+	assert.Equal(t, "a", testutil.ReadFile(t, filepath.Join(target, "a.txt")))
+
+	// A source file that is modified more recently is copied.
+	m.checksum = false
+	testutil.WriteFile(t, filepath.Join(source, "dir", "b.txt"), "c")
+	past := time.Now().Add(-time.Hour)
+	require.NoError(t, os.Chtimes(filepath.Join(target, "dir", "b.txt"), past, past))
+	require.NoError(t, m.run(source, target))
+	assert.Equal(t, "c", testutil.ReadFile(t, filepath.Join(target, "dir", "b.txt")))
+}
+
+func TestMirrorDelete(t *testing.T) {
+	source := t.TempDir()
+	target := t.TempDir()
+
+	testutil.WriteFile(t, filepath.Join(source, "a.txt"), "a")
+	testutil.WriteFile(t, filepath.Join(target, "extra.txt"), "extra")
+	testutil.WriteFile(t, filepath.Join(target, "dir", "extra.txt"), "extra")
+	testutil.WriteFile(t, filepath.Join(target, "keep", "extra.log"), "extra")
+
+	m := newTestMirror(t)
+	m.delete = true
+	m.excludeMatcher = ignore.CompileIgnoreLines("*.log")
+
+	// Nothing is modified in dry run mode.
+	m.dryRun = true
+	require.NoError(t, m.run(source, target))
+	assert.NoFileExists(t, filepath.Join(target, "a.txt"))
+	assert.FileExists(t, filepath.Join(target, "extra.txt"))
+
+	m.dryRun = false
+	require.NoError(t, m.run(source, target))
+	assert.FileExists(t, filepath.Join(target, "a.txt"))
+	assert.NoFileExists(t, filepath.Join(target, "extra.txt"))
+	assert.NoDirExists(t, filepath.Join(target, "dir"))
+
+	// Excluded files are not deleted.
+	assert.FileExists(t, filepath.Join(target, "keep", "extra.log"))
+}
+
+func TestMirrorSelected(t *testing.T) {
+	m := &mirror{
+		includeMatcher: ignore.CompileIgnoreLines("*.py", "data/"),
+		excludeMatcher: ignore.CompileIgnoreLines("test_*.py"),
+	}
+
+	assert.True(t, m.selected("main.py", false))
+	assert.True(t, m.selected("lib/util.py", false))
+	assert.True(t, m.selected("data/file.csv", false))
+	assert.False(t, m.selected("README.md", false))
+	assert.False(t, m.selected("lib/test_util.py", false))
+}
+
+func TestMirrorExcludedDirectories(t *testing.T) {
+	source := t.TempDir()
+	target := t.TempDir()
+
+	testutil.WriteFile(t, filepath.Join(source, "a.txt"), "a")
+	testutil.Touch(t, source, "node_modules", "pkg", "index.js")
+	require.NoError(t, os.MkdirAll(filepath.Join(source, "node_modules", "empty"), 0o755))
+	require.NoError(t, os.MkdirAll(filepath.Join(target, "node_modules", "cache"), 0o755))
+
+	m := newTestMirror(t)
+	m.delete = true
+	m.excludeMatcher = ignore.CompileIgnoreLines("node_modules/")
+	require.NoError(t, m.run(source, target))
+
+	// Excluded directories are neither created nor deleted.
+	assert.FileExists(t, filepath.Join(target, "a.txt"))
+	assert.NoDirExists(t, filepath.Join(target, "node_modules", "pkg"))
+	assert.NoDirExists(t, filepath.Join(target, "node_modules", "empty"))
+	assert.DirExists(t, filepath.Join(target, "node_modules", "cache"))
+}
@@ -0,0 +1,186 @@
+package fs
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/databricks/cli/cmd/root"
+	"github.com/databricks/cli/libs/filer"
+	"github.com/databricks/cli/libs/log"
+	"github.com/spf13/cobra"
+)
+
+// Number of bytes from the end of the file to search for the last lines,
+// if the filer supports reading part of a file.
+const tailInitialBytes = 1024 * 1024
+
+type tail struct {
+	lines    int
+	follow   bool
+	interval time.Duration
+
+	ctx  context.Context
+	f    filer.Filer
+	path string
+	out  io.Writer
+}
+
+// readFrom returns a reader for the file starting at the offset.
+func (t *tail) readFrom(offset int64) (io.ReadCloser, error) {
+	if rr, ok := t.f.(filer.RangeReader); ok {
+		return rr.ReadRange(t.ctx, t.path, offset)
+	}
+
+	r, err := t.f.Read(t.ctx, t.path)
+	if err != nil {
+		return nil, err
+	}
+	_, err = io.CopyN(io.Discard, r, offset)
+	if err != nil && !errors.Is(err, io.EOF) {
+		r.Close()
+		return nil, err
+	}
+	return r, nil
+}
+
+// lastLines returns the last n lines of the reader and the number of bytes read.
+// If partial is set, the reader starts in the middle of a line and the first line is ignored.
+func lastLines(r io.Reader, n int, partial bool) ([]byte, int64, error) {
+	br := bufio.NewReader(r)
+	ring := make([][]byte, 0, n)
+	var read int64
+
+	for first := true; ; first = false {
+		line, err := br.ReadBytes('\n')
+		read += int64(len(line))
+		if len(line) > 0 && n > 0 && !(first && partial) {
+			if len(ring) == n {
+				ring = append(ring[:0], ring[1:]...)
+			}
+			ring = append(ring, line)
+		}
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, read, err
+		}
+	}
+
+	return bytes.Join(ring, nil), read, nil
+}
+
+func (t *tail) run() error {
+	info, err := t.f.Stat(t.ctx, t.path)
+	if err != nil {
+		return err
+	}
+	if info.IsDir() {
+		return fmt.Errorf("%s is a directory", t.path)
+	}
+
+	var offset int64
+	if _, ok := t.f.(filer.RangeReader); ok && info.Size() > tailInitialBytes {
+		offset = info.Size() - tailInitialBytes
+	}
+
+	r, err := t.readFrom(offset)
+	if err != nil {
+		return err
+	}
+	last, n, err := lastLines(r, t.lines, offset > 0)
+	r.Close()
+	if err != nil {
+		return err
+	}
+	offset += n
+
+	_, err = t.out.Write(last)
+	if err != nil || !t.follow {
+		return err
+	}
+
+	ticker := time.NewTicker(t.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-t.ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		info, err := t.f.Stat(t.ctx, t.path)
+		if err != nil {
+			return err
+		}
+
+		if info.Size() < offset {
+			log.Warnf(t.ctx, "%s was truncated; reading from the beginning", t.path)
+			offset = 0
+		}
+		if info.Size() == offset {
+			continue
+		}
+
+		r, err := t.readFrom(offset)
+		if err != nil {
+			return err
+		}
+		n, err := io.Copy(t.out, r)
+		r.Close()
+		offset += n
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func newTailCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tail FILE_PATH",
+		Short: "Show the end of a file.",
+		Long: `Show the last lines of a file in DBFS, a UC Volume, the workspace or your local filesystem.
+
+	  With --follow, keep showing data as it is appended to the file until interrupted.
+	`,
+		Args:    root.ExactArgs(1),
+		PreRunE: root.MustWorkspaceClient,
+	}
+
+	var t tail
+	cmd.Flags().IntVarP(&t.lines, "lines", "n", 10, "number of lines to show")
+	cmd.Flags().BoolVarP(&t.follow, "follow", "f", false, "keep showing data as it is appended to the file")
+	cmd.Flags().DurationVar(&t.interval, "interval", time.Second, "how often to check for appended data with --follow")
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+
+		if t.lines < 0 {
+			return errors.New("--lines must not be negative")
+		}
+		if t.interval <= 0 {
+			return errors.New("--interval must be positive")
+		}
+
+		f, path, err := filerForPath(ctx, args[0])
+		if err != nil {
+			return err
+		}
+
+		t.ctx = ctx
+		t.f = f
+		t.path = path
+		t.out = cmd.OutOrStdout()
+		return t.run()
+	}
+
+	v := newValidArgs()
+	cmd.ValidArgsFunction = v.Validate
+
+	return cmd
+}
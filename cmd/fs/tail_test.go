@@ -0,0 +1,97 @@
+package fs
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/databricks/cli/internal/testutil"
+	"github.com/databricks/cli/libs/filer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLastLines(t *testing.T) {
+	input := "one\ntwo\nthree\nfour"
+
+	out, n, err := lastLines(strings.NewReader(input), 2, false)
+	require.NoError(t, err)
+	assert.Equal(t, "three\nfour", string(out))
+	assert.Equal(t, int64(len(input)), n)
+
+	out, _, err = lastLines(strings.NewReader(input), 10, false)
+	require.NoError(t, err)
+	assert.Equal(t, input, string(out))
+
+	// The first line is ignored if the input starts in the middle of a line.
+	out, _, err = lastLines(strings.NewReader(input), 10, true)
+	require.NoError(t, err)
+	assert.Equal(t, "two\nthree\nfour", string(out))
+
+	out, _, err = lastLines(strings.NewReader(input), 0, false)
+	require.NoError(t, err)
+	assert.Empty(t, out)
+}
+
+// syncBuffer is a buffer that is safe for concurrent use.
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
+
+func TestTailFollow(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	name := filepath.Join(t.TempDir(), "log.txt")
+	testutil.WriteFile(t, name, "one\ntwo\nthree\n")
+
+	f, err := filer.NewLocalClient("")
+	require.NoError(t, err)
+
+	var out syncBuffer
+	tl := tail{
+		lines:    2,
+		follow:   true,
+		interval: 10 * time.Millisecond,
+		ctx:      ctx,
+		f:        f,
+		path:     name,
+		out:      &out,
+	}
+
+	errs := make(chan error, 1)
+	go func() {
+		errs <- tl.run()
+	}()
+
+	assert.Eventually(t, func() bool { return out.String() == "two\nthree\n" }, 5*time.Second, 10*time.Millisecond)
+
+	file, err := os.OpenFile(name, os.O_APPEND|os.O_WRONLY, 0)
+	require.NoError(t, err)
+	_, err = file.WriteString("four\n")
+	require.NoError(t, err)
+	require.NoError(t, file.Close())
+
+	assert.Eventually(t, func() bool { return out.String() == "two\nthree\nfour\n" }, 5*time.Second, 10*time.Millisecond)
+
+	cancel()
+	assert.NoError(t, <-errs)
+}
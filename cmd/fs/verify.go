@@ -0,0 +1,298 @@
+package fs
+
+import (
+	"context"
+	"crypto/md5"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"io/fs"
+	"path"
+	"slices"
+	"sync"
+
+	"github.com/databricks/cli/cmd/root"
+	"github.com/databricks/cli/libs/cmdio"
+	"github.com/databricks/cli/libs/filer"
+	"github.com/databricks/cli/libs/utils"
+	"github.com/spf13/cobra"
+	"golang.org/x/sync/errgroup"
+)
+
+// checksumReader computes the size and the SHA-256 and MD5 checksums of the data read through it.
+// The MD5 checksum is only used to compare with checksums in the metadata of remote files.
+type checksumReader struct {
+	r      io.Reader
+	sha256 hash.Hash
+	md5    hash.Hash
+	n      int64
+}
+
+func newChecksumReader(r io.Reader) *checksumReader {
+	return &checksumReader{r: r, sha256: sha256.New(), md5: md5.New()}
+}
+
+func (r *checksumReader) Read(b []byte) (int, error) {
+	n, err := r.r.Read(b)
+	r.sha256.Write(b[:n])
+	r.md5.Write(b[:n])
+	r.n += int64(n)
+	return n, err
+}
+
+// checksums returns the hex-encoded checksums by the name of their algorithm, as used by [filer.Checksum].
+func (r *checksumReader) checksums() map[string]string {
+	return map[string]string{
+		"sha256": fmt.Sprintf("%x", r.sha256.Sum(nil)),
+		"md5":    fmt.Sprintf("%x", r.md5.Sum(nil)),
+	}
+}
+
+// fileMatches returns true if the file has the given size and checksums.
+// The size is compared first, using the file's metadata. If the size matches, the checksum
+// in the file's metadata is compared if the filer provides one. The file is only read to
+// compute its SHA-256 checksum if there is no such checksum or if it doesn't match, because
+// a checksum in the metadata, such as an ETag, is not guaranteed to be a hash of the content.
+func fileMatches(ctx context.Context, f filer.Filer, name string, size int64, checksums map[string]string) (bool, error) {
+	info, err := f.Stat(ctx, name)
+	if err != nil {
+		return false, err
+	}
+	if info.Size() != size {
+		return false, nil
+	}
+
+	if c, ok := f.(filer.Checksummer); ok {
+		checksum, err := c.Checksum(ctx, name)
+		if err != nil {
+			return false, err
+		}
+		if checksum != nil && checksum.Value == checksums[checksum.Algorithm] {
+			return true, nil
+		}
+	}
+
+	actual, err := hashFile(ctx, f, name)
+	if err != nil {
+		return false, err
+	}
+	return actual == checksums["sha256"], nil
+}
+
+type verifyReport struct {
+	Verified   []string `json:"verified"`
+	Mismatched []string `json:"mismatched"`
+	Missing    []string `json:"missing"`
+	Extra      []string `json:"extra"`
+}
+
+type verifier struct {
+	concurrency int
+
+	ctx          context.Context
+	localFiler   filer.Filer
+	remoteFiler  filer.Filer
+	remoteScheme string
+
+	// Guards the report because files are verified in parallel.
+	mu     sync.Mutex
+	report verifyReport
+}
+
+func (v *verifier) add(list *[]string, remotePath string) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	*list = append(*list, fullPath(v.remoteScheme, remotePath))
+}
+
+// verifyFile compares the checksums of a local and a remote file.
+func (v *verifier) verifyFile(localPath, remotePath string) error {
+	r, err := v.localFiler.Read(v.ctx, localPath)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	cr := newChecksumReader(r)
+	_, err = io.Copy(io.Discard, cr)
+	if err != nil {
+		return err
+	}
+
+	ok, err := fileMatches(v.ctx, v.remoteFiler, remotePath, cr.n, cr.checksums())
+	if errors.Is(err, fs.ErrNotExist) {
+		v.add(&v.report.Missing, remotePath)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if ok {
+		v.add(&v.report.Verified, remotePath)
+	} else {
+		v.add(&v.report.Mismatched, remotePath)
+	}
+	return nil
+}
+
+// verifyDir compares all files in a local and a remote directory.
+func (v *verifier) verifyDir(localDir, remoteDir string) error {
+	local, err := listTree(v.ctx, v.localFiler, localDir, selectAll)
+	if err != nil {
+		return err
+	}
+
+	// All files are missing if the remote directory doesn't exist.
+	remote, err := listTree(v.ctx, v.remoteFiler, remoteDir, selectAll)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+
+	g, ctx := errgroup.WithContext(v.ctx)
+	g.SetLimit(v.concurrency)
+
+	for _, relPath := range utils.SortedKeys(local.files) {
+		remotePath := path.Join(remoteDir, relPath)
+		remoteInfo, ok := remote.files[relPath]
+		if !ok {
+			v.add(&v.report.Missing, remotePath)
+			continue
+		}
+		if remoteInfo.Size() != local.files[relPath].Size() {
+			v.add(&v.report.Mismatched, remotePath)
+			continue
+		}
+
+		g.Go(func() error {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			return v.verifyFile(path.Join(localDir, relPath), remotePath)
+		})
+	}
+
+	for _, relPath := range utils.SortedKeys(remote.files) {
+		if _, ok := local.files[relPath]; !ok {
+			v.add(&v.report.Extra, path.Join(remoteDir, relPath))
+		}
+	}
+
+	return g.Wait()
+}
+
+func (v *verifier) run(localPath, remotePath string) error {
+	v.report = verifyReport{
+		Verified:   []string{},
+		Mismatched: []string{},
+		Missing:    []string{},
+		Extra:      []string{},
+	}
+
+	localInfo, err := v.localFiler.Stat(v.ctx, localPath)
+	if err != nil {
+		return err
+	}
+
+	remoteInfo, err := v.remoteFiler.Stat(v.ctx, remotePath)
+	remoteIsDir := err == nil && remoteInfo.IsDir()
+
+	if localInfo.IsDir() {
+		if err == nil && !remoteIsDir {
+			return fmt.Errorf("%s is not a directory", fullPath(v.remoteScheme, remotePath))
+		}
+		err = v.verifyDir(localPath, remotePath)
+	} else {
+		// Like with cp, a file is expected inside the remote path if it is a directory.
+		if remoteIsDir {
+			remotePath = path.Join(remotePath, path.Base(localPath))
+		}
+		err = v.verifyFile(localPath, remotePath)
+	}
+	if err != nil {
+		return err
+	}
+
+	slices.Sort(v.report.Verified)
+	slices.Sort(v.report.Mismatched)
+	slices.Sort(v.report.Missing)
+	slices.Sort(v.report.Extra)
+	return nil
+}
+
+func newVerifyCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify LOCAL_PATH REMOTE_PATH",
+		Short: "Verify that files were transferred correctly.",
+		Long: `Verify that the files at REMOTE_PATH are identical to the files at LOCAL_PATH.
+
+	  LOCAL_PATH and REMOTE_PATH can be a file or a directory, on DBFS, UC Volumes,
+	  the workspace or your local filesystem. Directories are compared recursively.
+
+	  Files are first compared by their size, taken from the file metadata. Files
+	  of the same size are compared by the checksum in the metadata of the remote
+	  file if the storage provides one. Otherwise they are compared by their
+	  SHA-256 checksum, which requires reading the remote file.
+
+	  Files that are different or missing at REMOTE_PATH are reported and cause the
+	  command to fail. Files at REMOTE_PATH that don't exist at LOCAL_PATH are
+	  reported as extra files.
+	`,
+		Args:    root.ExactArgs(2),
+		PreRunE: root.MustWorkspaceClient,
+	}
+
+	var v verifier
+	cmd.Flags().IntVar(&v.concurrency, "concurrency", 10, "number of files to verify in parallel")
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+
+		if v.concurrency < 1 {
+			return errors.New("--concurrency must be at least 1")
+		}
+
+		localFiler, localPath, err := filerForPath(ctx, args[0])
+		if err != nil {
+			return err
+		}
+
+		remoteFiler, remotePath, err := filerForPath(ctx, args[1])
+		if err != nil {
+			return err
+		}
+
+		v.ctx = ctx
+		v.localFiler = localFiler
+		v.remoteFiler = remoteFiler
+		v.remoteScheme = schemeForPath(args[1])
+
+		err = v.run(localPath, remotePath)
+		if err != nil {
+			return err
+		}
+
+		err = cmdio.RenderWithTemplate(ctx, v.report, "", cmdio.Heredoc(`
+		{{range .Mismatched}}mismatched: {{.}}
+		{{end}}{{range .Missing}}missing:    {{.}}
+		{{end}}{{range .Extra}}extra:      {{.}}
+		{{end}}{{len .Verified}} verified, {{len .Mismatched}} mismatched, {{len .Missing}} missing, {{len .Extra}} extra
+		`))
+		if err != nil {
+			return err
+		}
+
+		if len(v.report.Mismatched) > 0 || len(v.report.Missing) > 0 {
+			return root.ErrAlreadyPrinted
+		}
+		return nil
+	}
+
+	va := newValidArgs()
+	va.pathArgCount = 2
+	cmd.ValidArgsFunction = va.Validate
+
+	return cmd
+}
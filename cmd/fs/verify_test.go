@@ -0,0 +1,120 @@
+package fs
+
+import (
+	"context"
+	"io"
+	"path/filepath"
+	"testing"
+
+	"github.com/databricks/cli/internal/testutil"
+	"github.com/databricks/cli/libs/filer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestVerifier(t *testing.T) *verifier {
+	f, err := filer.NewLocalClient("")
+	require.NoError(t, err)
+
+	return &verifier{
+		concurrency: 2,
+		ctx:         context.Background(),
+		localFiler:  f,
+		remoteFiler: f,
+	}
+}
+
+func TestVerifyDir(t *testing.T) {
+	dir := filepath.ToSlash(t.TempDir())
+	testutil.WriteFile(t, filepath.Join(dir, "local", "same.txt"), "same")
+	testutil.WriteFile(t, filepath.Join(dir, "local", "a", "content.txt"), "abcd")
+	testutil.WriteFile(t, filepath.Join(dir, "local", "a", "size.txt"), "abcd")
+	testutil.WriteFile(t, filepath.Join(dir, "local", "missing.txt"), "missing")
+	testutil.WriteFile(t, filepath.Join(dir, "remote", "same.txt"), "same")
+	testutil.WriteFile(t, filepath.Join(dir, "remote", "a", "content.txt"), "abce")
+	testutil.WriteFile(t, filepath.Join(dir, "remote", "a", "size.txt"), "abcde")
+	testutil.WriteFile(t, filepath.Join(dir, "remote", "extra.txt"), "extra")
+
+	v := newTestVerifier(t)
+	require.NoError(t, v.run(dir+"/local", dir+"/remote"))
+	assert.Equal(t, verifyReport{
+		Verified:   []string{dir + "/remote/same.txt"},
+		Mismatched: []string{dir + "/remote/a/content.txt", dir + "/remote/a/size.txt"},
+		Missing:    []string{dir + "/remote/missing.txt"},
+		Extra:      []string{dir + "/remote/extra.txt"},
+	}, v.report)
+
+	// All files are missing if the remote directory doesn't exist.
+	require.NoError(t, v.run(dir+"/local/a", dir+"/remote/b"))
+	assert.Equal(t, []string{dir + "/remote/b/content.txt", dir + "/remote/b/size.txt"}, v.report.Missing)
+}
+
+func TestVerifyFile(t *testing.T) {
+	dir := filepath.ToSlash(t.TempDir())
+	testutil.WriteFile(t, filepath.Join(dir, "a.txt"), "abcd")
+	testutil.WriteFile(t, filepath.Join(dir, "remote", "a.txt"), "abcd")
+	testutil.WriteFile(t, filepath.Join(dir, "remote", "b.txt"), "abce")
+
+	v := newTestVerifier(t)
+	require.NoError(t, v.run(dir+"/a.txt", dir+"/remote/b.txt"))
+	assert.Equal(t, []string{dir + "/remote/b.txt"}, v.report.Mismatched)
+
+	// The file is expected inside the remote directory.
+	require.NoError(t, v.run(dir+"/a.txt", dir+"/remote"))
+	assert.Equal(t, []string{dir + "/remote/a.txt"}, v.report.Verified)
+
+	require.NoError(t, v.run(dir+"/a.txt", dir+"/remote/c.txt"))
+	assert.Equal(t, []string{dir + "/remote/c.txt"}, v.report.Missing)
+
+	err := v.run(dir+"/remote", dir+"/a.txt")
+	assert.ErrorContains(t, err, "is not a directory")
+}
+
+// checksumFiler is a filer that returns a fixed checksum from the metadata of every file and counts the files it reads.
+type checksumFiler struct {
+	filer.Filer
+	checksum *filer.Checksum
+	reads    int
+}
+
+func (f *checksumFiler) Checksum(ctx context.Context, name string) (*filer.Checksum, error) {
+	return f.checksum, nil
+}
+
+func (f *checksumFiler) Read(ctx context.Context, name string) (io.ReadCloser, error) {
+	f.reads++
+	return f.Filer.Read(ctx, name)
+}
+
+func TestVerifyFileUsesChecksumFromMetadata(t *testing.T) {
+	dir := filepath.ToSlash(t.TempDir())
+	testutil.WriteFile(t, filepath.Join(dir, "a.txt"), "abc")
+	testutil.WriteFile(t, filepath.Join(dir, "remote", "a.txt"), "abc")
+
+	v := newTestVerifier(t)
+	remote := &checksumFiler{Filer: v.remoteFiler}
+	v.remoteFiler = remote
+
+	// The remote file is not read if the checksum in its metadata matches.
+	remote.checksum = &filer.Checksum{Algorithm: "md5", Value: "900150983cd24fb0d6963f7d28e17f72"}
+	require.NoError(t, v.run(dir+"/a.txt", dir+"/remote/a.txt"))
+	assert.Equal(t, []string{dir + "/remote/a.txt"}, v.report.Verified)
+	assert.Equal(t, 0, remote.reads)
+
+	remote.checksum = &filer.Checksum{Algorithm: "sha256", Value: "ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad"}
+	require.NoError(t, v.run(dir+"/a.txt", dir+"/remote/a.txt"))
+	assert.Equal(t, []string{dir + "/remote/a.txt"}, v.report.Verified)
+	assert.Equal(t, 0, remote.reads)
+
+	// The remote file is read if the checksum doesn't match, because it may not be a hash of the content.
+	remote.checksum = &filer.Checksum{Algorithm: "md5", Value: "00000000000000000000000000000000"}
+	require.NoError(t, v.run(dir+"/a.txt", dir+"/remote/a.txt"))
+	assert.Equal(t, []string{dir + "/remote/a.txt"}, v.report.Verified)
+	assert.Equal(t, 1, remote.reads)
+
+	// The remote file is read if its metadata has no checksum.
+	remote.checksum = nil
+	require.NoError(t, v.run(dir+"/a.txt", dir+"/remote/a.txt"))
+	assert.Equal(t, []string{dir + "/remote/a.txt"}, v.report.Verified)
+	assert.Equal(t, 2, remote.reads)
+}
@@ -26,15 +26,17 @@ import (
 
 type syncFlags struct {
 	// project files polling interval
-	interval    time.Duration
-	full        bool
-	watch       bool
-	output      flags.Output
-	exclude     []string
-	include     []string
-	dryRun      bool
-	excludeFrom string
-	includeFrom string
+	interval      time.Duration
+	full          bool
+	watch         bool
+	watchMode     sync.WatchMode
+	output        flags.Output
+	exclude       []string
+	include       []string
+	dryRun        bool
+	bidirectional bool
+	excludeFrom   string
+	includeFrom   string
 }
 
 func readPatternsFile(filePath string) ([]string, error) {
@@ -83,12 +85,14 @@ func (f *syncFlags) syncOptionsFromBundle(cmd *cobra.Command, args []string, b *
 
 	opts.Full = f.full
 	opts.PollInterval = f.interval
+	opts.WatchMode = f.watchMode
 	opts.WorktreeRoot = b.WorktreeRoot
 	opts.Exclude = append(opts.Exclude, f.exclude...)
 	opts.Exclude = append(opts.Exclude, excludePatterns...)
 	opts.Include = append(opts.Include, f.include...)
 	opts.Include = append(opts.Include, includePatterns...)
 	opts.DryRun = f.dryRun
+	opts.Bidirectional = f.bidirectional
 	return opts, nil
 }
 
@@ -153,6 +157,7 @@ func (f *syncFlags) syncOptionsFromArgs(cmd *cobra.Command, args []string) (*syn
 		RemotePath:   args[1],
 		Full:         f.full,
 		PollInterval: f.interval,
+		WatchMode:    f.watchMode,
 
 		// We keep existing behavior for VS Code extension where if there is
 		// no bundle defined, we store the snapshots in `.databricks`.
@@ -163,6 +168,7 @@ func (f *syncFlags) syncOptionsFromArgs(cmd *cobra.Command, args []string) (*syn
 
 		OutputHandler: outputHandler,
 		DryRun:        f.dryRun,
+		Bidirectional: f.bidirectional,
 	}
 	return &opts, nil
 }
@@ -176,17 +182,20 @@ func New() *cobra.Command {
 	}
 
 	f := syncFlags{
-		output: flags.OutputText,
+		output:    flags.OutputText,
+		watchMode: sync.WatchModeNotify,
 	}
-	cmd.Flags().DurationVar(&f.interval, "interval", 1*time.Second, "file system polling interval (for --watch)")
+	cmd.Flags().DurationVar(&f.interval, "interval", 1*time.Second, "file system polling interval (for --watch in poll mode)")
 	cmd.Flags().BoolVar(&f.full, "full", false, "perform full synchronization (default is incremental)")
 	cmd.Flags().BoolVar(&f.watch, "watch", false, "watch local file system for changes")
+	cmd.Flags().Var(&f.watchMode, "watch-mode", "how to detect changes for --watch: notify (file system notifications, falls back to poll if unavailable) or poll")
 	cmd.Flags().Var(&f.output, "output", "type of output format")
 	cmd.Flags().StringSliceVar(&f.exclude, "exclude", nil, "patterns to exclude from sync (can be specified multiple times)")
 	cmd.Flags().StringSliceVar(&f.include, "include", nil, "patterns to include in sync (can be specified multiple times)")
 	cmd.Flags().StringVar(&f.excludeFrom, "exclude-from", "", "file containing patterns to exclude from sync (one pattern per line)")
 	cmd.Flags().StringVar(&f.includeFrom, "include-from", "", "file containing patterns to include to sync (one pattern per line)")
 	cmd.Flags().BoolVar(&f.dryRun, "dry-run", false, "simulate sync execution without making actual changes")
+	cmd.Flags().BoolVar(&f.bidirectional, "bidirectional", false, "also download files that were modified in the workspace; files modified on both sides are written to <name>.remote")
 
 	// Wrapper for [root.MustWorkspaceClient] that disables loading authentication configuration from a bundle.
 	mustWorkspaceClient := func(cmd *cobra.Command, args []string) error {
@@ -10,6 +10,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/databricks/cli/cmd/root"
 	"github.com/databricks/cli/internal/testcli"
 	"github.com/databricks/cli/internal/testutil"
 	"github.com/databricks/cli/libs/filer"
@@ -374,3 +375,82 @@ func TestFsCpSourceIsDirectoryButTargetIsFile(t *testing.T) {
 		})
 	}
 }
+
+func TestFsCpLargeFile(t *testing.T) {
+	t.Parallel()
+
+	for _, testCase := range copyTests() {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			sourceFiler, sourceDir := testCase.setupSource(t)
+			targetFiler, targetDir := testCase.setupTarget(t)
+
+			// Large enough to be uploaded in multiple parts to UC Volumes.
+			content := strings.Repeat("0123456789abcdef", 3*1024*1024)
+			err := sourceFiler.Write(ctx, "large.bin", strings.NewReader(content))
+			require.NoError(t, err)
+
+			testcli.RequireSuccessfulRun(t, ctx, "fs", "cp", path.Join(sourceDir, "large.bin"), path.Join(targetDir, "large.bin"))
+			assertFileContent(t, ctx, targetFiler, "large.bin", content)
+		})
+	}
+}
+
+func TestFsCpDirWithVerifyFlag(t *testing.T) {
+	t.Parallel()
+
+	for _, testCase := range copyTests() {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			sourceFiler, sourceDir := testCase.setupSource(t)
+			targetFiler, targetDir := testCase.setupTarget(t)
+			setupSourceDir(t, ctx, sourceFiler)
+
+			testcli.RequireSuccessfulRun(t, ctx, "fs", "cp", sourceDir, targetDir, "--recursive", "--verify")
+			assertTargetDir(t, ctx, targetFiler)
+
+			stdout, _ := testcli.RequireSuccessfulRun(t, ctx, "fs", "verify", sourceDir, targetDir)
+			assert.Equal(t, "3 verified, 0 mismatched, 0 missing, 0 extra\n", stdout.String())
+
+			// Changed and missing files are reported.
+			err := targetFiler.Write(ctx, "query.sql", strings.NewReader("SELECT 2"), filer.OverwriteIfExists)
+			require.NoError(t, err)
+			err = targetFiler.Delete(ctx, "a/b/c/hello.txt")
+			require.NoError(t, err)
+
+			stdout, _, err = testcli.RequireErrorRun(t, ctx, "fs", "verify", sourceDir, targetDir)
+			assert.ErrorIs(t, err, root.ErrAlreadyPrinted)
+			assert.Equal(t, strings.Join([]string{
+				"mismatched: " + path.Join(targetDir, "query.sql"),
+				"missing:    " + path.Join(targetDir, "a/b/c/hello.txt"),
+				"1 verified, 1 mismatched, 1 missing, 0 extra",
+			}, "\n")+"\n", stdout.String())
+		})
+	}
+}
+
+func TestFsCpDirWithArchiveFlag(t *testing.T) {
+	t.Parallel()
+
+	for _, testCase := range copyTests() {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			sourceFiler, sourceDir := testCase.setupSource(t)
+			targetFiler, targetDir := testCase.setupTarget(t)
+			setupSourceDir(t, ctx, sourceFiler)
+
+			testcli.RequireSuccessfulRun(t, ctx, "fs", "cp", sourceDir, path.Join(targetDir, "archive.tar.gz"), "--archive")
+			testcli.RequireSuccessfulRun(t, ctx, "fs", "extract", path.Join(targetDir, "archive.tar.gz"), path.Join(targetDir, "extracted"))
+
+			assertFileContent(t, ctx, targetFiler, "extracted/pyNb.py", "# Databricks notebook source\nprint(123)")
+			assertFileContent(t, ctx, targetFiler, "extracted/query.sql", "SELECT 1")
+			assertFileContent(t, ctx, targetFiler, "extracted/a/b/c/hello.txt", "hello, world\n")
+		})
+	}
+}
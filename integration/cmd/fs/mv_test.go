@@ -0,0 +1,53 @@
+package fs_test
+
+import (
+	"context"
+	"io/fs"
+	"path"
+	"testing"
+
+	"github.com/databricks/cli/internal/testcli"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFsMvDir(t *testing.T) {
+	t.Parallel()
+
+	for _, testCase := range copyTests() {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			sourceFiler, sourceDir := testCase.setupSource(t)
+			targetFiler, targetDir := testCase.setupTarget(t)
+			setupSourceDir(t, ctx, sourceFiler)
+
+			testcli.RequireSuccessfulRun(t, ctx, "fs", "mv", path.Join(sourceDir, "a"), path.Join(targetDir, "b"))
+
+			assertFileContent(t, ctx, targetFiler, "b/b/c/hello.txt", "hello, world\n")
+			_, err := sourceFiler.Stat(ctx, "a")
+			assert.ErrorIs(t, err, fs.ErrNotExist)
+		})
+	}
+}
+
+func TestFsMvFile(t *testing.T) {
+	t.Parallel()
+
+	for _, testCase := range copyTests() {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			sourceFiler, sourceDir := testCase.setupSource(t)
+			targetFiler, targetDir := testCase.setupTarget(t)
+			setupSourceFile(t, ctx, sourceFiler)
+
+			testcli.RequireSuccessfulRun(t, ctx, "fs", "mv", path.Join(sourceDir, "foo.txt"), path.Join(targetDir, "bar.txt"))
+
+			assertTargetFile(t, ctx, targetFiler, "bar.txt")
+			_, err := sourceFiler.Stat(ctx, "foo.txt")
+			assert.ErrorIs(t, err, fs.ErrNotExist)
+		})
+	}
+}
@@ -150,3 +150,44 @@ func TestFsRmDirRecursively(t *testing.T) {
 		})
 	}
 }
+
+func TestFsRmGlob(t *testing.T) {
+	t.Parallel()
+
+	for _, testCase := range fsTests {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			f, tmpDir := testCase.setupFiler(t)
+			for _, name := range []string{"a/1.parquet", "a/2.parquet", "a/keep.txt", "b/3.parquet"} {
+				err := f.Write(ctx, name, strings.NewReader("abcd"), filer.CreateParentDirectories)
+				require.NoError(t, err)
+			}
+
+			// Run rm command with --dry-run
+			stdout, stderr := testcli.RequireSuccessfulRun(t, ctx, "fs", "rm", path.Join(tmpDir, "**/*.parquet"), "--dry-run")
+			assert.Equal(t, "", stderr.String())
+			assert.Equal(t, strings.Join([]string{
+				"(dry run) deleted " + path.Join(tmpDir, "b/3.parquet"),
+				"(dry run) deleted " + path.Join(tmpDir, "a/2.parquet"),
+				"(dry run) deleted " + path.Join(tmpDir, "a/1.parquet"),
+			}, "\n")+"\n", stdout.String())
+
+			// Assert no files were deleted
+			_, err := f.Stat(ctx, "a/1.parquet")
+			assert.NoError(t, err)
+
+			// Run rm command
+			testcli.RequireSuccessfulRun(t, ctx, "fs", "rm", path.Join(tmpDir, "**/*.parquet"))
+
+			// Assert only matching files were deleted
+			for _, name := range []string{"a/1.parquet", "a/2.parquet", "b/3.parquet"} {
+				_, err = f.Stat(ctx, name)
+				assert.ErrorIs(t, err, fs.ErrNotExist)
+			}
+			_, err = f.Stat(ctx, "a/keep.txt")
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,40 @@
+package fs_test
+
+import (
+	"context"
+	"io/fs"
+	"strings"
+	"testing"
+
+	"github.com/databricks/cli/internal/testcli"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFsSyncDir(t *testing.T) {
+	t.Parallel()
+
+	for _, testCase := range copyTests() {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			sourceFiler, sourceDir := testCase.setupSource(t)
+			targetFiler, targetDir := testCase.setupTarget(t)
+			setupSourceDir(t, ctx, sourceFiler)
+
+			err := targetFiler.Write(ctx, "extra.txt", strings.NewReader("extra"))
+			require.NoError(t, err)
+
+			testcli.RequireSuccessfulRun(t, ctx, "fs", "sync", sourceDir, targetDir, "--delete")
+
+			assertTargetDir(t, ctx, targetFiler)
+			_, err = targetFiler.Stat(ctx, "extra.txt")
+			assert.ErrorIs(t, err, fs.ErrNotExist)
+
+			// Running it again does not copy anything.
+			stdout, _ := testcli.RequireSuccessfulRun(t, ctx, "fs", "sync", sourceDir, targetDir, "--delete", "--checksum")
+			assert.Equal(t, "", stdout.String())
+		})
+	}
+}
@@ -2,8 +2,10 @@ package dagrun
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 	"sync"
+	"sync/atomic"
 )
 
 type StringerComparable interface {
@@ -36,6 +38,11 @@ func (g *Graph[N]) AddNode(n N) {
 	}
 }
 
+// Nodes returns all nodes of the graph in insertion order.
+func (g *Graph[N]) Nodes() []N {
+	return slices.Clone(g.nodes)
+}
+
 func (g *Graph[N]) HasNode(n N) bool {
 	_, ok := g.adj[n]
 	return ok
@@ -71,6 +78,67 @@ func (g *Graph[N]) OutgoingLabels(node N) []string {
 	return labels
 }
 
+// OutgoingNodes returns the targets of the outgoing edges from the given node whose label
+// satisfies match, in the order the edges were added. Every target is returned once.
+func (g *Graph[N]) OutgoingNodes(node N, match func(label string) bool) []N {
+	var nodes []N
+	for _, e := range g.adj[node] {
+		if match(e.label) && !slices.Contains(nodes, e.to) {
+			nodes = append(nodes, e.to)
+		}
+	}
+	return nodes
+}
+
+// Subgraph returns a new graph with the given nodes and all nodes they depend on, directly or
+// transitively, together with the edges between them. Nodes keep their insertion order.
+// Nodes that are unknown to the graph are ignored.
+func (g *Graph[N]) Subgraph(nodes []N) *Graph[N] {
+	incoming := make(map[N][]N, len(g.adj))
+	for _, from := range g.nodes {
+		for _, e := range g.adj[from] {
+			incoming[e.to] = append(incoming[e.to], from)
+		}
+	}
+
+	keep := make(map[N]bool, len(nodes))
+	var st stack[N]
+	for _, n := range nodes {
+		if g.HasNode(n) && !keep[n] {
+			keep[n] = true
+			st.push(n)
+		}
+	}
+	for st.len() > 0 {
+		n := st.pop()
+		for _, dep := range incoming[n] {
+			if !keep[dep] {
+				keep[dep] = true
+				st.push(dep)
+			}
+		}
+	}
+
+	sub := NewGraph[N]()
+	for _, n := range g.nodes {
+		if keep[n] {
+			sub.AddNode(n)
+		}
+	}
+	for _, from := range g.nodes {
+		if !keep[from] {
+			continue
+		}
+		// If a node is kept, so are all of its dependencies; checking the target is enough.
+		for _, e := range g.adj[from] {
+			if keep[e.to] {
+				sub.AddDirectedEdge(from, e.to, e.label)
+			}
+		}
+	}
+	return sub
+}
+
 type CycleError[N comparable] struct {
 	Nodes []N
 	Edges []string
@@ -185,6 +253,27 @@ func (g *Graph[N]) DetectCycle() error {
 // The callback should return true on success or false on failure. Nodes are not
 // skipped when dependencies fail; instead, they are executed with failedDependency set.
 func (g *Graph[N]) Run(pool int, runUnit func(node N, failedDependency *N) bool) {
+	g.run(pool, false, runUnit)
+}
+
+// RunUntilFailure executes the DAG with up to pool concurrent workers, same as Run,
+// except that no new nodes are started once any node has failed. Nodes that are already
+// running are allowed to finish. Since every dependency of a started node has succeeded,
+// failedDependency passed to runUnit is always nil.
+//
+// Returns the nodes that were not started, in insertion order.
+func (g *Graph[N]) RunUntilFailure(pool int, runUnit func(node N, failedDependency *N) bool) []N {
+	skipped := g.run(pool, true, runUnit)
+	var result []N
+	for _, n := range g.nodes {
+		if skipped[n] {
+			result = append(result, n)
+		}
+	}
+	return result
+}
+
+func (g *Graph[N]) run(pool int, stopOnFailure bool, runUnit func(node N, failedDependency *N) bool) map[N]bool {
 	if pool <= 0 || pool > len(g.adj) {
 		pool = len(g.adj)
 	}
@@ -207,13 +296,19 @@ func (g *Graph[N]) Run(pool int, runUnit func(node N, failedDependency *N) bool)
 	// For each node, remember a failed direct dependency (any one) if present.
 	failedCause := make(map[N]*N, len(in))
 
+	// Nodes that were not started because an earlier node failed (stopOnFailure only).
+	skipped := make(map[N]bool)
+
+	// Set on the first failure if stopOnFailure is true; workers skip tasks once it is set.
+	var stopped atomic.Bool
+
 	ready := make(chan task[N], len(in))
 	done := make(chan doneResult[N], len(in))
 
 	var wg sync.WaitGroup
 	wg.Add(pool)
 	for range pool {
-		go runWorkerLoop(&wg, ready, done, runUnit)
+		go runWorkerLoop(&wg, ready, done, stopOnFailure, &stopped, runUnit)
 	}
 
 	for _, n := range initial {
@@ -223,6 +318,10 @@ func (g *Graph[N]) Run(pool int, runUnit func(node N, failedDependency *N) bool)
 	for remaining := len(in); remaining > 0; remaining-- {
 		res := <-done
 
+		if res.skipped {
+			skipped[res.n] = true
+		}
+
 		if !res.success {
 			// Determine the originating failure cause to propagate
 			var cause *N
@@ -249,11 +348,14 @@ func (g *Graph[N]) Run(pool int, runUnit func(node N, failedDependency *N) bool)
 	}
 	close(ready)
 	wg.Wait()
+
+	return skipped
 }
 
 type doneResult[N StringerComparable] struct {
 	n       N
 	success bool
+	skipped bool
 }
 
 type task[T StringerComparable] struct {
@@ -261,14 +363,23 @@ type task[T StringerComparable] struct {
 	failedFrom *T
 }
 
-func runWorkerLoop[N StringerComparable](wg *sync.WaitGroup, ready <-chan task[N], done chan<- doneResult[N], runUnit func(N, *N) bool) {
+func runWorkerLoop[N StringerComparable](wg *sync.WaitGroup, ready <-chan task[N], done chan<- doneResult[N], stopOnFailure bool, stopped *atomic.Bool, runUnit func(N, *N) bool) {
 	defer wg.Done()
 	for t := range ready {
+		if stopped.Load() {
+			// Skipped nodes count as failed, so that their dependents are skipped as well
+			done <- doneResult[N]{n: t.n, success: false, skipped: true}
+			continue
+		}
 		success := runUnit(t.n, t.failedFrom)
 		if t.failedFrom != nil {
 			// Enforce failure status when a dependency has failed
 			success = false
 		}
+		if !success && stopOnFailure {
+			// Set before reporting, so that this worker does not pick up another task in the meantime
+			stopped.Store(true)
+		}
 		done <- doneResult[N]{n: t.n, success: success}
 	}
 }
@@ -222,6 +222,141 @@ func runTestCase(t *testing.T, tc testCase, g *Graph[stringWrapper], p int) {
 	}
 }
 
+func TestRunUntilFailure_StopsScheduling(t *testing.T) {
+	g := NewGraph[stringWrapper]()
+	for _, n := range []string{"A", "B", "C", "D"} {
+		g.AddNode(stringWrapper{n})
+	}
+	g.AddDirectedEdge(stringWrapper{"A"}, stringWrapper{"E"}, "A->E")
+
+	var seen []string
+	skipped := g.RunUntilFailure(1, func(n stringWrapper, failed *stringWrapper) bool {
+		assert.Nil(t, failed)
+		seen = append(seen, n.Value)
+		return n.Value != "B"
+	})
+
+	assert.Equal(t, []string{"A", "B"}, seen)
+	assert.Equal(t, []stringWrapper{{"C"}, {"D"}, {"E"}}, skipped)
+}
+
+func TestRunUntilFailure_SkipsDependents(t *testing.T) {
+	g := NewGraph[stringWrapper]()
+	g.AddDirectedEdge(stringWrapper{"A"}, stringWrapper{"B"}, "A->B")
+	g.AddDirectedEdge(stringWrapper{"B"}, stringWrapper{"C"}, "B->C")
+
+	for _, p := range []int{1, 2, 3} {
+		var seen []string
+		skipped := g.RunUntilFailure(p, func(n stringWrapper, failed *stringWrapper) bool {
+			seen = append(seen, n.Value)
+			return false
+		})
+
+		assert.Equal(t, []string{"A"}, seen)
+		assert.Equal(t, []stringWrapper{{"B"}, {"C"}}, skipped)
+	}
+}
+
+func TestRunUntilFailure_InFlightNodesFinish(t *testing.T) {
+	g := NewGraph[stringWrapper]()
+	for _, n := range []string{"A", "B", "C", "D", "E"} {
+		g.AddNode(stringWrapper{n})
+	}
+
+	started := make(chan struct{})
+	failed := make(chan struct{})
+
+	var mu sync.Mutex
+	var seen []string
+	skipped := g.RunUntilFailure(2, func(n stringWrapper, _ *stringWrapper) bool {
+		mu.Lock()
+		seen = append(seen, n.Value)
+		mu.Unlock()
+
+		switch n.Value {
+		case "A":
+			// A is still running when B fails.
+			close(started)
+			<-failed
+			return true
+		case "B":
+			<-started
+			defer close(failed)
+			return false
+		}
+		return true
+	})
+
+	// A finishes even though B failed while it was running. The worker that ran A may race
+	// with the worker that ran B and start one more node; the rest are never started.
+	assert.Contains(t, seen, "A")
+	assert.Contains(t, seen, "B")
+	assert.LessOrEqual(t, len(seen), 3)
+	assert.Len(t, skipped, 5-len(seen))
+}
+
+func TestRunUntilFailure_AllSucceed(t *testing.T) {
+	g := NewGraph[stringWrapper]()
+	g.AddDirectedEdge(stringWrapper{"A"}, stringWrapper{"B"}, "A->B")
+	g.AddNode(stringWrapper{"C"})
+
+	var mu sync.Mutex
+	var seen []string
+	skipped := g.RunUntilFailure(2, func(n stringWrapper, _ *stringWrapper) bool {
+		mu.Lock()
+		seen = append(seen, n.Value)
+		mu.Unlock()
+		return true
+	})
+
+	sort.Strings(seen)
+	assert.Equal(t, []string{"A", "B", "C"}, seen)
+	assert.Empty(t, skipped)
+}
+
+func TestSubgraph(t *testing.T) {
+	g := NewGraph[stringWrapper]()
+	// B and C reference A, D references C, E is independent.
+	g.AddDirectedEdge(stringWrapper{"A"}, stringWrapper{"B"}, "A->B")
+	g.AddDirectedEdge(stringWrapper{"A"}, stringWrapper{"C"}, "A->C")
+	g.AddDirectedEdge(stringWrapper{"C"}, stringWrapper{"D"}, "C->D")
+	g.AddNode(stringWrapper{"E"})
+
+	sub := g.Subgraph([]stringWrapper{{"D"}, {"unknown"}})
+	assert.Equal(t, []stringWrapper{{"A"}, {"C"}, {"D"}}, sub.nodes)
+	assert.Equal(t, []string{"A->C"}, sub.OutgoingLabels(stringWrapper{"A"}))
+	assert.Equal(t, []string{"C->D"}, sub.OutgoingLabels(stringWrapper{"C"}))
+	assert.False(t, sub.HasNode(stringWrapper{"B"}))
+
+	sub = g.Subgraph([]stringWrapper{{"A"}, {"E"}})
+	assert.Equal(t, []stringWrapper{{"A"}, {"E"}}, sub.nodes)
+	assert.False(t, sub.HasOutgoingEdges(stringWrapper{"A"}))
+
+	assert.Equal(t, 0, g.Subgraph(nil).Size())
+
+	// The original graph is not modified.
+	assert.Equal(t, 5, g.Size())
+}
+
+func TestOutgoingNodes(t *testing.T) {
+	g := NewGraph[stringWrapper]()
+	a := stringWrapper{"A"}
+	b := stringWrapper{"B"}
+	c := stringWrapper{"C"}
+
+	g.AddDirectedEdge(a, b, "id")
+	g.AddDirectedEdge(a, c, "name")
+	g.AddDirectedEdge(a, b, "id")
+	g.AddDirectedEdge(a, b, "name")
+
+	isID := func(label string) bool { return label == "id" }
+	assert.Equal(t, []stringWrapper{b}, g.OutgoingNodes(a, isID))
+	assert.Empty(t, g.OutgoingNodes(b, isID))
+
+	all := func(string) bool { return true }
+	assert.Equal(t, []stringWrapper{b, c}, g.OutgoingNodes(a, all))
+}
+
 func TestOutgoingLabels_OrderAndEmpty(t *testing.T) {
 	g := NewGraph[stringWrapper]()
 	a := stringWrapper{"A"}
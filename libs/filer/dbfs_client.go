@@ -305,3 +305,40 @@ func (w *DbfsClient) Stat(ctx context.Context, name string) (fs.FileInfo, error)
 
 	return dbfsFileInfo{*info}, nil
 }
+
+func (w *DbfsClient) Rename(ctx context.Context, oldName, newName string) error {
+	oldPath, err := w.root.Join(oldName)
+	if err != nil {
+		return err
+	}
+
+	newPath, err := w.root.Join(newName)
+	if err != nil {
+		return err
+	}
+
+	err = w.workspaceClient.Dbfs.Move(ctx, files.Move{
+		SourcePath:      oldPath,
+		DestinationPath: newPath,
+	})
+
+	// Return early on success.
+	if err == nil {
+		return nil
+	}
+
+	// Special handling of this error only if it is an API error.
+	var aerr *apierr.APIError
+	if !errors.As(err, &aerr) {
+		return err
+	}
+
+	switch {
+	case aerr.StatusCode == http.StatusNotFound && aerr.ErrorCode == "RESOURCE_DOES_NOT_EXIST":
+		return FileDoesNotExistError{oldPath}
+	case aerr.StatusCode == http.StatusBadRequest && aerr.ErrorCode == "RESOURCE_ALREADY_EXISTS":
+		return FileAlreadyExistsError{newPath}
+	}
+
+	return err
+}
@@ -146,3 +146,34 @@ type Filer interface {
 	// Stat returns information about the file at `path`.
 	Stat(ctx context.Context, name string) (fs.FileInfo, error)
 }
+
+// Renamer is implemented by filers that can rename a file or directory
+// without copying its contents.
+type Renamer interface {
+	// Rename the file or directory at `oldPath` to `newPath`.
+	// Returns an error wrapping [errors.ErrUnsupported] if the paths cannot be renamed
+	// with a single operation (e.g. because they are on different file systems).
+	Rename(ctx context.Context, oldPath, newPath string) error
+}
+
+// Checksum is a checksum of the content of a file.
+type Checksum struct {
+	// Algorithm is the hash function, either "sha256" or "md5".
+	Algorithm string
+
+	// Value is the hex-encoded hash.
+	Value string
+}
+
+// Checksummer is implemented by filers that can return a checksum of a file
+// from its metadata, without reading the file.
+type Checksummer interface {
+	// Checksum returns the checksum of the file at `path`, or nil if its metadata does not include one.
+	Checksum(ctx context.Context, path string) (*Checksum, error)
+}
+
+// RangeReader is implemented by filers that can read a file starting at an offset.
+type RangeReader interface {
+	// ReadRange reads the file at `path` starting at `offset`.
+	ReadRange(ctx context.Context, path string, offset int64) (io.ReadCloser, error)
+}
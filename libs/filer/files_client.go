@@ -1,6 +1,7 @@
 package filer
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
@@ -93,6 +94,12 @@ type FilesClient struct {
 
 	// File operations will be relative to this path.
 	root WorkspaceRootPath
+
+	// Files larger than this are uploaded in parts of this size.
+	partSize int64
+
+	// Client to upload parts to the presigned URLs of a multipart upload.
+	httpClient *http.Client
 }
 
 func NewFilesClient(w *databricks.WorkspaceClient, root string) (Filer, error) {
@@ -106,6 +113,9 @@ func NewFilesClient(w *databricks.WorkspaceClient, root string) (Filer, error) {
 		apiClient:       apiClient,
 
 		root: NewWorkspaceRootPath(root),
+
+		partSize:   multipartUploadPartSize,
+		httpClient: presignedHTTPClient(w.Config),
 	}, nil
 }
 
@@ -146,15 +156,41 @@ func (w *FilesClient) Write(ctx context.Context, name string, reader io.Reader,
 	}
 
 	overwrite := slices.Contains(mode, OverwriteIfExists)
+
+	// Read the first part to determine if the file is large enough for a multipart upload.
+	var first bytes.Buffer
+	_, err = io.CopyN(&first, reader, w.partSize)
+	if errors.Is(err, io.EOF) {
+		return w.putFile(ctx, absPath, urlPath, &first, overwrite)
+	}
+	if err != nil {
+		return err
+	}
+
+	// Only the parts of multipart uploads count towards the memory that all uploads may use to buffer parts.
+	// The multipart upload releases the memory of the first part once it is uploaded.
+	err = multipartUploadMemory.Acquire(ctx, w.partSize)
+	if err != nil {
+		return err
+	}
+	return w.multipartUpload(ctx, absPath, urlPath, first.Bytes(), reader, overwrite)
+}
+
+// putFile uploads the file in a single request.
+func (w *FilesClient) putFile(ctx context.Context, absPath, urlPath string, reader io.Reader, overwrite bool) error {
 	urlPath = fmt.Sprintf("%s?overwrite=%t", urlPath, overwrite)
 	headers := map[string]string{"Content-Type": "application/octet-stream"}
-	err = w.apiClient.Do(ctx, http.MethodPut, urlPath, headers, nil, reader, nil)
+	err := w.apiClient.Do(ctx, http.MethodPut, urlPath, headers, nil, reader, nil)
 
 	// Return early on success.
 	if err == nil {
 		return nil
 	}
 
+	return fileWriteError(err, absPath)
+}
+
+func fileWriteError(err error, absPath string) error {
 	// Special handling of this error only if it is an API error.
 	var aerr *apierr.APIError
 	if !errors.As(err, &aerr) {
@@ -203,6 +239,40 @@ func (w *FilesClient) Read(ctx context.Context, name string) (io.ReadCloser, err
 	return nil, err
 }
 
+func (w *FilesClient) ReadRange(ctx context.Context, name string, offset int64) (io.ReadCloser, error) {
+	absPath, urlPath, err := w.urlPath(name)
+	if err != nil {
+		return nil, err
+	}
+
+	var reader io.ReadCloser
+	headers := map[string]string{"Range": fmt.Sprintf("bytes=%d-", offset)}
+	err = w.apiClient.Do(ctx, http.MethodGet, urlPath, headers, nil, nil, &reader)
+
+	// Return early on success.
+	if err == nil {
+		return reader, nil
+	}
+
+	// Special handling of this error only if it is an API error.
+	var aerr *apierr.APIError
+	if !errors.As(err, &aerr) {
+		return nil, err
+	}
+
+	// This API returns a 404 if the specified path does not exist.
+	if aerr.StatusCode == http.StatusNotFound {
+		return nil, FileDoesNotExistError{absPath}
+	}
+
+	// This API returns a 416 if the offset is at or past the end of the file.
+	if aerr.StatusCode == http.StatusRequestedRangeNotSatisfiable {
+		return io.NopCloser(strings.NewReader("")), nil
+	}
+
+	return nil, err
+}
+
 func (w *FilesClient) deleteFile(ctx context.Context, name string) error {
 	absPath, err := w.root.Join(name)
 	if err != nil {
@@ -0,0 +1,105 @@
+package filer
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/databricks/databricks-sdk-go/apierr"
+)
+
+// fileChecksumHeaders are the response headers of a HEAD request for a file that may include a checksum.
+type fileChecksumHeaders struct {
+	// Digest of the content as defined in RFC 9530, e.g. "sha-256=:<base64>:".
+	ReprDigest string `header:"repr-digest,omitempty"`
+
+	// Digest of the content as defined in RFC 3230, e.g. "SHA-256=<base64>".
+	Digest string `header:"digest,omitempty"`
+
+	// Base64-encoded MD5 hash of the content.
+	ContentMD5 string `header:"content-md5,omitempty"`
+
+	ETag string `header:"etag,omitempty"`
+}
+
+// Checksum returns the checksum of the file from the headers of a HEAD request, if the storage includes one.
+func (w *FilesClient) Checksum(ctx context.Context, name string) (*Checksum, error) {
+	absPath, urlPath, err := w.urlPath(name)
+	if err != nil {
+		return nil, err
+	}
+
+	var headers fileChecksumHeaders
+	err = w.apiClient.Do(ctx, http.MethodHead, urlPath, nil, nil, nil, &headers)
+
+	var aerr *apierr.APIError
+	if errors.As(err, &aerr) && aerr.StatusCode == http.StatusNotFound {
+		return nil, FileDoesNotExistError{absPath}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	for _, digest := range []string{headers.ReprDigest, headers.Digest} {
+		if c := parseDigest(digest); c != nil {
+			return c, nil
+		}
+	}
+	if c := base64Checksum("md5", headers.ContentMD5); c != nil {
+		return c, nil
+	}
+	return etagChecksum(headers.ETag), nil
+}
+
+// parseDigest returns the SHA-256 or MD5 checksum from a Repr-Digest or Digest header.
+func parseDigest(header string) *Checksum {
+	for item := range strings.SplitSeq(header, ",") {
+		algorithm, value, ok := strings.Cut(strings.TrimSpace(item), "=")
+		if !ok {
+			continue
+		}
+		value = strings.Trim(value, ":")
+		switch strings.ToLower(algorithm) {
+		case "sha-256":
+			if c := base64Checksum("sha256", value); c != nil {
+				return c
+			}
+		case "md5":
+			if c := base64Checksum("md5", value); c != nil {
+				return c
+			}
+		}
+	}
+	return nil
+}
+
+func base64Checksum(algorithm, value string) *Checksum {
+	if value == "" {
+		return nil
+	}
+	b, err := base64.StdEncoding.DecodeString(value)
+	if err != nil {
+		return nil
+	}
+	return &Checksum{Algorithm: algorithm, Value: hex.EncodeToString(b)}
+}
+
+// etagChecksum returns the MD5 checksum of the file if its ETag is one. Object stores such as S3 use the MD5 hash
+// of the content as the ETag of objects uploaded in a single request. The ETags of other objects have a different
+// format, but an ETag that looks like an MD5 hash may still not be one, so callers must not rely on a mismatch.
+func etagChecksum(etag string) *Checksum {
+	if strings.HasPrefix(etag, "W/") {
+		return nil
+	}
+	etag = strings.ToLower(strings.Trim(etag, `"`))
+	if len(etag) != 32 {
+		return nil
+	}
+	if _, err := hex.DecodeString(etag); err != nil {
+		return nil
+	}
+	return &Checksum{Algorithm: "md5", Value: etag}
+}
@@ -0,0 +1,327 @@
+package filer
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/databricks/cli/libs/log"
+	"github.com/databricks/databricks-sdk-go/config"
+	"golang.org/x/sync/errgroup"
+	"golang.org/x/sync/semaphore"
+)
+
+const (
+	// Files larger than this are uploaded in parts of this size.
+	multipartUploadPartSize = 16 * 1024 * 1024
+
+	// Number of parts of a single file that are uploaded in parallel.
+	multipartUploadConcurrency = 4
+
+	// Memory that all multipart uploads together may use to buffer parts. Multipart uploads
+	// wait for buffered parts to be uploaded once this is used up. Files smaller than a part
+	// are uploaded in a single request and don't count towards it.
+	multipartUploadMaxMemory = 8 * multipartUploadPartSize
+
+	// Number of attempts to upload a single part before giving up.
+	multipartUploadMaxAttempts = 5
+
+	// Presigned URLs are valid for this long.
+	multipartUploadUrlExpiry = time.Hour
+)
+
+// Reserves the memory of every buffered part, across all uploads.
+var multipartUploadMemory = semaphore.NewWeighted(multipartUploadMaxMemory)
+
+// Initial backoff between attempts to upload a part. It doubles after every attempt.
+// This is a variable so that tests can run without waiting.
+var multipartUploadRetryBackoff = time.Second
+
+type presignedUrlHeader struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type presignedUrl struct {
+	Url        string               `json:"url"`
+	PartNumber int                  `json:"part_number,omitempty"`
+	Headers    []presignedUrlHeader `json:"headers,omitempty"`
+}
+
+type initiateUploadResponse struct {
+	MultipartUpload *struct {
+		SessionToken string `json:"session_token"`
+	} `json:"multipart_upload,omitempty"`
+}
+
+type createUploadPartUrlsRequest struct {
+	Path            string `json:"path"`
+	SessionToken    string `json:"session_token"`
+	StartPartNumber int    `json:"start_part_number"`
+	Count           int    `json:"count"`
+	ExpireTime      string `json:"expire_time"`
+}
+
+type createUploadPartUrlsResponse struct {
+	UploadPartUrls []presignedUrl `json:"upload_part_urls"`
+}
+
+type createAbortUploadUrlRequest struct {
+	Path         string `json:"path"`
+	SessionToken string `json:"session_token"`
+	ExpireTime   string `json:"expire_time"`
+}
+
+type createAbortUploadUrlResponse struct {
+	AbortUploadUrl presignedUrl `json:"abort_upload_url"`
+}
+
+type uploadedPart struct {
+	PartNumber int    `json:"part_number"`
+	ETag       string `json:"etag"`
+}
+
+type completeUploadRequest struct {
+	Parts []uploadedPart `json:"parts"`
+}
+
+var jsonHeaders = map[string]string{
+	"Accept":       "application/json",
+	"Content-Type": "application/json",
+}
+
+// multipartUpload uploads the file in parts. Parts are uploaded in parallel and
+// each part is retried individually, so that a transient network error does not
+// require uploading the whole file again.
+//
+// The file consists of the first part followed by the rest of the reader. The memory of the
+// first part must be reserved in [multipartUploadMemory]; it is released once the part is uploaded.
+//
+// Falls back to uploading the file in a single request if the workspace does not support multipart uploads.
+func (w *FilesClient) multipartUpload(ctx context.Context, absPath, urlPath string, first []byte, reader io.Reader, overwrite bool) error {
+	var initiate initiateUploadResponse
+	err := w.apiClient.Do(ctx, http.MethodPost, urlPath, jsonHeaders, map[string]any{
+		"action":    "initiate-upload",
+		"overwrite": overwrite,
+	}, nil, &initiate)
+	if err != nil {
+		multipartUploadMemory.Release(w.partSize)
+		return fileWriteError(err, absPath)
+	}
+
+	if initiate.MultipartUpload == nil {
+		defer multipartUploadMemory.Release(w.partSize)
+		log.Debugf(ctx, "Multipart upload is not supported for %s; uploading in a single request", absPath)
+		return w.putFile(ctx, absPath, urlPath, io.MultiReader(bytes.NewReader(first), reader), overwrite)
+	}
+
+	sessionToken := initiate.MultipartUpload.SessionToken
+	parts, err := w.uploadParts(ctx, absPath, sessionToken, first, reader)
+	if err != nil {
+		w.abortUpload(ctx, absPath, sessionToken)
+		return err
+	}
+
+	err = w.apiClient.Do(ctx, http.MethodPost, urlPath, jsonHeaders, map[string]any{
+		"action":        "complete-upload",
+		"upload_type":   "multipart",
+		"session_token": sessionToken,
+	}, completeUploadRequest{Parts: parts}, nil)
+	if err != nil {
+		w.abortUpload(ctx, absPath, sessionToken)
+		return fileWriteError(err, absPath)
+	}
+
+	return nil
+}
+
+// uploadParts uploads the first part and the parts read from the reader in parallel.
+// The memory of every part is reserved in [multipartUploadMemory] until it is uploaded,
+// and at most [multipartUploadConcurrency] parts of the file are uploaded at a time.
+func (w *FilesClient) uploadParts(ctx context.Context, absPath, sessionToken string, first []byte, reader io.Reader) ([]uploadedPart, error) {
+	var mu sync.Mutex
+	var parts []uploadedPart
+
+	group, groupCtx := errgroup.WithContext(ctx)
+	group.SetLimit(multipartUploadConcurrency)
+
+	upload := func(partNumber int, data []byte) {
+		group.Go(func() error {
+			defer multipartUploadMemory.Release(w.partSize)
+
+			etag, err := w.uploadPart(groupCtx, absPath, sessionToken, partNumber, data)
+			if err != nil {
+				return err
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			parts = append(parts, uploadedPart{PartNumber: partNumber, ETag: etag})
+			return nil
+		})
+	}
+
+	upload(1, first)
+	for partNumber := 2; ; partNumber++ {
+		// Fails if the context is cancelled, e.g. because a part failed to upload.
+		if multipartUploadMemory.Acquire(groupCtx, w.partSize) != nil {
+			break
+		}
+
+		buf := make([]byte, w.partSize)
+		n, err := io.ReadFull(reader, buf)
+		if errors.Is(err, io.EOF) {
+			multipartUploadMemory.Release(w.partSize)
+			break
+		}
+		last := errors.Is(err, io.ErrUnexpectedEOF)
+		if err != nil && !last {
+			multipartUploadMemory.Release(w.partSize)
+			// Wait for in-flight parts before returning.
+			_ = group.Wait()
+			return nil, err
+		}
+
+		upload(partNumber, buf[:n])
+		if last {
+			break
+		}
+	}
+
+	err := group.Wait()
+	if err != nil {
+		return nil, err
+	}
+
+	// The context may have been cancelled without a part failing.
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+
+	sort.Slice(parts, func(i, j int) bool { return parts[i].PartNumber < parts[j].PartNumber })
+	return parts, nil
+}
+
+// uploadPart uploads a single part, retrying with exponential backoff on failure.
+// A new presigned URL is requested for every attempt, in case the previous one expired.
+func (w *FilesClient) uploadPart(ctx context.Context, absPath, sessionToken string, partNumber int, data []byte) (string, error) {
+	backoff := multipartUploadRetryBackoff
+
+	var err error
+	for attempt := 1; ; attempt++ {
+		var etag string
+		etag, err = w.tryUploadPart(ctx, absPath, sessionToken, partNumber, data)
+		if err == nil {
+			return etag, nil
+		}
+
+		if attempt == multipartUploadMaxAttempts || ctx.Err() != nil {
+			break
+		}
+
+		log.Debugf(ctx, "Retrying upload of part %d of %s in %s: %s", partNumber, absPath, backoff, err)
+		select {
+		case <-ctx.Done():
+			return "", ctx.Err()
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+
+	return "", fmt.Errorf("failed to upload part %d of %s: %w", partNumber, absPath, err)
+}
+
+func (w *FilesClient) tryUploadPart(ctx context.Context, absPath, sessionToken string, partNumber int, data []byte) (string, error) {
+	var res createUploadPartUrlsResponse
+	err := w.apiClient.Do(ctx, http.MethodPost, "/api/2.0/fs/create-upload-part-urls", jsonHeaders, nil, createUploadPartUrlsRequest{
+		Path:            absPath,
+		SessionToken:    sessionToken,
+		StartPartNumber: partNumber,
+		Count:           1,
+		ExpireTime:      time.Now().Add(multipartUploadUrlExpiry).UTC().Format(time.RFC3339),
+	}, &res)
+	if err != nil {
+		return "", err
+	}
+	if len(res.UploadPartUrls) != 1 {
+		return "", fmt.Errorf("expected 1 upload URL for part %d, got %d", partNumber, len(res.UploadPartUrls))
+	}
+
+	resp, err := w.doPresigned(ctx, http.MethodPut, res.UploadPartUrls[0], bytes.NewReader(data))
+	if err != nil {
+		return "", err
+	}
+
+	etag := resp.Header.Get("ETag")
+	if etag == "" {
+		return "", fmt.Errorf("response for part %d does not include an ETag", partNumber)
+	}
+	return etag, nil
+}
+
+// abortUpload aborts the multipart upload so that its parts are cleaned up. Errors are ignored.
+func (w *FilesClient) abortUpload(ctx context.Context, absPath, sessionToken string) {
+	// The upload must be aborted even if the context was cancelled.
+	ctx = context.WithoutCancel(ctx)
+
+	var res createAbortUploadUrlResponse
+	err := w.apiClient.Do(ctx, http.MethodPost, "/api/2.0/fs/create-abort-upload-url", jsonHeaders, nil, createAbortUploadUrlRequest{
+		Path:         absPath,
+		SessionToken: sessionToken,
+		ExpireTime:   time.Now().Add(multipartUploadUrlExpiry).UTC().Format(time.RFC3339),
+	}, &res)
+	if err == nil {
+		_, err = w.doPresigned(ctx, http.MethodDelete, res.AbortUploadUrl, nil)
+	}
+	if err != nil {
+		log.Debugf(ctx, "Failed to abort multipart upload of %s: %s", absPath, err)
+	}
+}
+
+// doPresigned issues a request to a presigned URL. These URLs point to cloud storage
+// and must not include the workspace credentials.
+func (w *FilesClient) doPresigned(ctx context.Context, method string, u presignedUrl, body io.Reader) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, method, u.Url, body)
+	if err != nil {
+		return nil, err
+	}
+	for _, h := range u.Headers {
+		req.Header.Set(h.Name, h.Value)
+	}
+
+	resp, err := w.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return nil, fmt.Errorf("%s %s: %s: %s", method, req.URL.Host, resp.Status, bytes.TrimSpace(msg))
+	}
+
+	_, _ = io.Copy(io.Discard, resp.Body)
+	return resp, nil
+}
+
+// presignedHTTPClient returns the client for requests to presigned URLs. It uses the transport settings
+// of the workspace client, such as a custom transport or skipping TLS verification, but not its credentials.
+// Like the SDK, the default transport uses the proxy configured in the environment.
+func presignedHTTPClient(cfg *config.Config) *http.Client {
+	if cfg.HTTPTransport != nil {
+		return &http.Client{Transport: cfg.HTTPTransport}
+	}
+	if cfg.InsecureSkipVerify {
+		t := http.DefaultTransport.(*http.Transport).Clone()
+		t.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+		return &http.Client{Transport: t}
+	}
+	return http.DefaultClient
+}
@@ -0,0 +1,309 @@
+package filer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/databricks/databricks-sdk-go"
+	"github.com/databricks/databricks-sdk-go/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeFilesServer implements the parts of the Files API used to upload files.
+type fakeFilesServer struct {
+	*httptest.Server
+
+	// Set to false to simulate a workspace that does not support multipart uploads.
+	multipart bool
+
+	// Number of times an upload of each part fails before it succeeds.
+	partFailures int
+
+	// Headers of the response to a HEAD request for a file.
+	headers http.Header
+
+	mu       sync.Mutex
+	files    map[string]string
+	parts    map[int]string
+	attempts map[int]int
+	aborted  bool
+}
+
+func newFakeFilesServer(t *testing.T) *fakeFilesServer {
+	s := &fakeFilesServer{
+		multipart: true,
+		files:     make(map[string]string),
+		parts:     make(map[int]string),
+		attempts:  make(map[int]int),
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("PUT /api/2.0/fs/files/{path...}", func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.files["/"+r.PathValue("path")] = string(b)
+	})
+	mux.HandleFunc("HEAD /api/2.0/fs/files/{path...}", func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		if _, ok := s.files["/"+r.PathValue("path")]; !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		for k, v := range s.headers {
+			w.Header()[k] = v
+		}
+	})
+	mux.HandleFunc("POST /api/2.0/fs/files/{path...}", func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		switch r.URL.Query().Get("action") {
+		case "initiate-upload":
+			if s.multipart {
+				fmt.Fprint(w, `{"multipart_upload": {"session_token": "token"}}`)
+			} else {
+				fmt.Fprint(w, `{}`)
+			}
+		case "complete-upload":
+			var req completeUploadRequest
+			_ = json.NewDecoder(r.Body).Decode(&req)
+			var content strings.Builder
+			for _, p := range req.Parts {
+				assert.Equal(t, fmt.Sprintf("etag-%d", p.PartNumber), p.ETag)
+				content.WriteString(s.parts[p.PartNumber])
+			}
+			s.files["/"+r.PathValue("path")] = content.String()
+			fmt.Fprint(w, `{}`)
+		}
+	})
+	mux.HandleFunc("POST /api/2.0/fs/create-upload-part-urls", func(w http.ResponseWriter, r *http.Request) {
+		var req createUploadPartUrlsRequest
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		_ = json.NewEncoder(w).Encode(createUploadPartUrlsResponse{
+			UploadPartUrls: []presignedUrl{{
+				Url:        fmt.Sprintf("%s/storage/%d", s.URL, req.StartPartNumber),
+				PartNumber: req.StartPartNumber,
+				Headers:    []presignedUrlHeader{{Name: "Content-Type", Value: "application/octet-stream"}},
+			}},
+		})
+	})
+	mux.HandleFunc("PUT /storage/{part}", func(w http.ResponseWriter, r *http.Request) {
+		assert.Empty(t, r.Header.Get("Authorization"))
+		var part int
+		_, _ = fmt.Sscan(r.PathValue("part"), &part)
+		b, _ := io.ReadAll(r.Body)
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.attempts[part]++
+		if s.attempts[part] <= s.partFailures {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		s.parts[part] = string(b)
+		w.Header().Set("ETag", fmt.Sprintf("etag-%d", part))
+	})
+	mux.HandleFunc("POST /api/2.0/fs/create-abort-upload-url", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"abort_upload_url": {"url": "%s/storage/abort"}}`, s.URL)
+	})
+	mux.HandleFunc("DELETE /storage/abort", func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.aborted = true
+	})
+
+	s.Server = httptest.NewServer(mux)
+	t.Cleanup(s.Close)
+	return s
+}
+
+func newTestFilesClient(t *testing.T, s *fakeFilesServer) Filer {
+	w, err := databricks.NewWorkspaceClient(&databricks.Config{
+		Host:  s.URL,
+		Token: "token",
+	})
+	require.NoError(t, err)
+
+	f, err := NewFilesClient(w, "/Volumes/main/default/data")
+	require.NoError(t, err)
+	f.(*FilesClient).partSize = 4
+	return f
+}
+
+// assertMemoryReleased asserts that the memory reserved to buffer parts was released.
+func assertMemoryReleased(t *testing.T) {
+	require.True(t, multipartUploadMemory.TryAcquire(multipartUploadMaxMemory))
+	multipartUploadMemory.Release(multipartUploadMaxMemory)
+}
+
+func TestFilesClientWriteSmallFile(t *testing.T) {
+	s := newFakeFilesServer(t)
+	f := newTestFilesClient(t, s)
+
+	err := f.Write(context.Background(), "a.txt", strings.NewReader("abc"), CreateParentDirectories)
+	require.NoError(t, err)
+	assert.Equal(t, "abc", s.files["/Volumes/main/default/data/a.txt"])
+	assert.Empty(t, s.attempts)
+	assertMemoryReleased(t)
+}
+
+func TestFilesClientWriteMultipart(t *testing.T) {
+	multipartUploadRetryBackoff = 0
+	t.Cleanup(func() { multipartUploadRetryBackoff = time.Second })
+
+	s := newFakeFilesServer(t)
+	s.partFailures = 2
+	f := newTestFilesClient(t, s)
+
+	err := f.Write(context.Background(), "a.txt", strings.NewReader("0123456789"), CreateParentDirectories)
+	require.NoError(t, err)
+	assert.Equal(t, "0123456789", s.files["/Volumes/main/default/data/a.txt"])
+
+	// Failed parts are retried individually.
+	assert.Equal(t, map[int]int{1: 3, 2: 3, 3: 3}, s.attempts)
+	assert.False(t, s.aborted)
+	assertMemoryReleased(t)
+}
+
+func TestFilesClientWriteMultipartFailure(t *testing.T) {
+	multipartUploadRetryBackoff = 0
+	t.Cleanup(func() { multipartUploadRetryBackoff = time.Second })
+
+	s := newFakeFilesServer(t)
+	s.partFailures = multipartUploadMaxAttempts
+	f := newTestFilesClient(t, s)
+
+	err := f.Write(context.Background(), "a.txt", strings.NewReader("0123456789"), CreateParentDirectories)
+	assert.ErrorContains(t, err, "failed to upload part")
+	assert.True(t, s.aborted)
+	assert.NotContains(t, s.files, "/Volumes/main/default/data/a.txt")
+	assertMemoryReleased(t)
+}
+
+func TestFilesClientWriteMultipartNotSupported(t *testing.T) {
+	s := newFakeFilesServer(t)
+	s.multipart = false
+	f := newTestFilesClient(t, s)
+
+	err := f.Write(context.Background(), "a.txt", strings.NewReader("0123456789"), CreateParentDirectories)
+	require.NoError(t, err)
+	assert.Equal(t, "0123456789", s.files["/Volumes/main/default/data/a.txt"])
+	assertMemoryReleased(t)
+}
+
+func TestFilesClientWriteWaitsForMemory(t *testing.T) {
+	s := newFakeFilesServer(t)
+	f := newTestFilesClient(t, s)
+
+	// Other uploads use all memory to buffer parts.
+	require.True(t, multipartUploadMemory.TryAcquire(multipartUploadMaxMemory))
+	defer multipartUploadMemory.Release(multipartUploadMaxMemory)
+
+	// Files smaller than a part are uploaded without reserving memory.
+	err := f.Write(context.Background(), "small.txt", strings.NewReader("abc"), CreateParentDirectories)
+	require.NoError(t, err)
+	assert.Equal(t, "abc", s.files["/Volumes/main/default/data/small.txt"])
+
+	// Multipart uploads wait for memory to buffer their parts.
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	err = f.Write(ctx, "large.txt", strings.NewReader("0123456789"), CreateParentDirectories)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.NotContains(t, s.files, "/Volumes/main/default/data/large.txt")
+}
+
+// recordingTransport records the paths of the requests it sends.
+type recordingTransport struct {
+	mu    sync.Mutex
+	paths []string
+}
+
+func (rt *recordingTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	rt.mu.Lock()
+	rt.paths = append(rt.paths, r.URL.Path)
+	rt.mu.Unlock()
+	return http.DefaultTransport.RoundTrip(r)
+}
+
+func TestFilesClientWriteMultipartUsesConfiguredTransport(t *testing.T) {
+	s := newFakeFilesServer(t)
+	rt := &recordingTransport{}
+	w, err := databricks.NewWorkspaceClient(&databricks.Config{
+		Host:          s.URL,
+		Token:         "token",
+		HTTPTransport: rt,
+	})
+	require.NoError(t, err)
+
+	f, err := NewFilesClient(w, "/Volumes/main/default/data")
+	require.NoError(t, err)
+	f.(*FilesClient).partSize = 4
+
+	err = f.Write(context.Background(), "a.txt", strings.NewReader("0123456789"), CreateParentDirectories)
+	require.NoError(t, err)
+
+	// Parts are uploaded to the presigned URLs through the transport of the workspace client.
+	assert.Contains(t, rt.paths, "/storage/1")
+	assert.Contains(t, rt.paths, "/storage/3")
+}
+
+func TestPresignedHTTPClient(t *testing.T) {
+	assert.Same(t, http.DefaultClient, presignedHTTPClient(&config.Config{}))
+
+	c := presignedHTTPClient(&config.Config{InsecureSkipVerify: true})
+	assert.True(t, c.Transport.(*http.Transport).TLSClientConfig.InsecureSkipVerify)
+}
+
+func TestFilesClientChecksum(t *testing.T) {
+	s := newFakeFilesServer(t)
+	f := newTestFilesClient(t, s)
+	s.files["/Volumes/main/default/data/a.txt"] = "abc"
+	ctx := context.Background()
+
+	tcs := []struct {
+		headers  http.Header
+		expected *Checksum
+	}{
+		{
+			headers:  http.Header{"Repr-Digest": {"sha-256=:ungWv48Bz+pBQUDeXa4iI7ADYaOWF3qctBD/YfIAFa0=:"}},
+			expected: &Checksum{Algorithm: "sha256", Value: "ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad"},
+		},
+		{
+			headers:  http.Header{"Digest": {"MD5=kAFQmDzST7DWlj99KOF/cg=="}},
+			expected: &Checksum{Algorithm: "md5", Value: "900150983cd24fb0d6963f7d28e17f72"},
+		},
+		{
+			headers:  http.Header{"Content-Md5": {"kAFQmDzST7DWlj99KOF/cg=="}},
+			expected: &Checksum{Algorithm: "md5", Value: "900150983cd24fb0d6963f7d28e17f72"},
+		},
+		{
+			headers:  http.Header{"Etag": {`"900150983CD24FB0D6963F7D28E17F72"`}},
+			expected: &Checksum{Algorithm: "md5", Value: "900150983cd24fb0d6963f7d28e17f72"},
+		},
+		// ETags of multipart uploads are not a checksum of the content.
+		{headers: http.Header{"Etag": {`"900150983cd24fb0d6963f7d28e17f72-2"`}}},
+		{headers: http.Header{}},
+	}
+
+	for _, tc := range tcs {
+		s.headers = tc.headers
+		c, err := f.(Checksummer).Checksum(ctx, "a.txt")
+		require.NoError(t, err)
+		assert.Equal(t, tc.expected, c, tc.headers)
+	}
+
+	_, err := f.(Checksummer).Checksum(ctx, "missing.txt")
+	assert.ErrorIs(t, err, fs.ErrNotExist)
+}
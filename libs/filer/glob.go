@@ -0,0 +1,164 @@
+package filer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"slices"
+	"strings"
+)
+
+// HasGlob returns true if the path contains any of the special characters of a glob pattern.
+func HasGlob(p string) bool {
+	return strings.ContainsAny(p, "*?[")
+}
+
+// Glob returns the paths of all files and directories that match the pattern, in lexical order.
+//
+// The pattern syntax is that of [path.Match], with the addition of "**" as a path element,
+// which matches zero or more directories. A trailing "**" matches all files and directories
+// below the directory that precedes it. Paths are separated by forward slashes.
+//
+// The pattern is matched one path element at a time using [Filer.ReadDir], starting
+// with the longest prefix that doesn't contain any special characters.
+// Only the directories that may contain matches are listed.
+func Glob(ctx context.Context, f Filer, pattern string) ([]string, error) {
+	pattern = path.Clean(pattern)
+	elems := strings.Split(pattern, "/")
+	for _, elem := range elems {
+		if _, err := path.Match(elem, ""); err != nil {
+			return nil, fmt.Errorf("invalid pattern %q: %w", pattern, err)
+		}
+	}
+
+	if !slices.ContainsFunc(elems, HasGlob) {
+		_, err := f.Stat(ctx, pattern)
+		if errors.Is(err, fs.ErrNotExist) {
+			return []string{}, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		return []string{pattern}, nil
+	}
+
+	// Start with the longest prefix that can be used as is.
+	dir := GlobPrefix(pattern)
+	i := slices.IndexFunc(elems, HasGlob)
+
+	g := globber{ctx: ctx, f: f, seen: make(map[string]bool), matches: []string{}}
+	err := g.glob(dir, elems[i:])
+	if err != nil {
+		return nil, err
+	}
+
+	slices.Sort(g.matches)
+	return g.matches, nil
+}
+
+// GlobPrefix returns the longest leading part of the pattern whose path elements don't contain any special characters.
+// All matches of the pattern are located below it. The prefix is empty for a relative pattern that starts with a special character.
+func GlobPrefix(pattern string) string {
+	pattern = path.Clean(pattern)
+	elems := strings.Split(pattern, "/")
+	i := slices.IndexFunc(elems, HasGlob)
+	if i < 0 {
+		return pattern
+	}
+
+	dir := strings.Join(elems[:i], "/")
+	if dir == "" && strings.HasPrefix(pattern, "/") {
+		dir = "/"
+	}
+	return dir
+}
+
+type globber struct {
+	ctx     context.Context
+	f       Filer
+	seen    map[string]bool
+	matches []string
+}
+
+func (g *globber) add(p string) {
+	if !g.seen[p] {
+		g.seen[p] = true
+		g.matches = append(g.matches, p)
+	}
+}
+
+// join joins a directory and a name, where an empty directory refers to the current directory.
+func join(dir, name string) string {
+	if dir == "" {
+		return name
+	}
+	return path.Join(dir, name)
+}
+
+// glob adds the paths below dir that match the remaining pattern elements.
+func (g *globber) glob(dir string, elems []string) error {
+	if len(elems) == 0 {
+		g.add(dir)
+		return nil
+	}
+
+	// Match zero directories.
+	if elems[0] == "**" && len(elems) > 1 {
+		err := g.glob(dir, elems[1:])
+		if err != nil {
+			return err
+		}
+	}
+
+	readDir := dir
+	if readDir == "" {
+		readDir = "."
+	}
+	entries, err := g.f.ReadDir(g.ctx, readDir)
+
+	// Directories that don't exist don't contain any matches.
+	if errors.Is(err, fs.ErrNotExist) || errors.As(err, &NotADirectory{}) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		name := join(dir, entry.Name())
+
+		// Match one or more directories. A trailing "**" also matches files.
+		if elems[0] == "**" {
+			if len(elems) == 1 {
+				g.add(name)
+			}
+			if entry.IsDir() {
+				err = g.glob(name, elems)
+				if err != nil {
+					return err
+				}
+			}
+			continue
+		}
+
+		ok, err := path.Match(elems[0], entry.Name())
+		if err != nil {
+			return err
+		}
+		if !ok {
+			continue
+		}
+		if len(elems) == 1 {
+			g.add(name)
+		} else if entry.IsDir() {
+			err = g.glob(name, elems[1:])
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,127 @@
+package filer
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHasGlob(t *testing.T) {
+	assert.True(t, HasGlob("/a/*.json"))
+	assert.True(t, HasGlob("/a/part-?"))
+	assert.True(t, HasGlob("/a/[ab]"))
+	assert.False(t, HasGlob("/a/b.json"))
+}
+
+func TestGlobPrefix(t *testing.T) {
+	assert.Equal(t, "/logs", GlobPrefix("/logs/2025-*/part-*.json"))
+	assert.Equal(t, "/", GlobPrefix("/*/a"))
+	assert.Equal(t, "a/b", GlobPrefix("./a/b/**"))
+	assert.Equal(t, "", GlobPrefix("*.json"))
+	assert.Equal(t, "/a/b.json", GlobPrefix("/a/b.json"))
+}
+
+func TestGlob(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	for _, name := range []string{
+		"logs/2025-01/part-0.json",
+		"logs/2025-01/part-1.json",
+		"logs/2025-01/_SUCCESS",
+		"logs/2025-02/part-0.json",
+		"logs/2024-12/part-0.json",
+		"logs/2025-03/nested/part-0.json",
+		"logs/README.md",
+	} {
+		require.NoError(t, os.MkdirAll(filepath.Join(dir, filepath.Dir(name)), 0o755))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(name), 0o644))
+	}
+
+	f, err := NewLocalClient(dir)
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		pattern string
+		matches []string
+	}{
+		{
+			pattern: "logs/2025-*/part-*.json",
+			matches: []string{
+				"logs/2025-01/part-0.json",
+				"logs/2025-01/part-1.json",
+				"logs/2025-02/part-0.json",
+			},
+		},
+		{
+			pattern: "logs/2025-0[12]",
+			matches: []string{
+				"logs/2025-01",
+				"logs/2025-02",
+			},
+		},
+		{
+			pattern: "logs/**/part-0.json",
+			matches: []string{
+				"logs/2024-12/part-0.json",
+				"logs/2025-01/part-0.json",
+				"logs/2025-02/part-0.json",
+				"logs/2025-03/nested/part-0.json",
+			},
+		},
+		{
+			pattern: "**/*.md",
+			matches: []string{
+				"logs/README.md",
+			},
+		},
+		{
+			pattern: "logs/2025-03/**",
+			matches: []string{
+				"logs/2025-03/nested",
+				"logs/2025-03/nested/part-0.json",
+			},
+		},
+		{
+			pattern: "logs/*/_SUCCESS",
+			matches: []string{
+				"logs/2025-01/_SUCCESS",
+			},
+		},
+		{
+			pattern: "logs/README.md",
+			matches: []string{
+				"logs/README.md",
+			},
+		},
+		{
+			pattern: "logs/*.csv",
+			matches: []string{},
+		},
+		{
+			pattern: "missing/*",
+			matches: []string{},
+		},
+		{
+			pattern: "logs/README.md/*",
+			matches: []string{},
+		},
+	} {
+		t.Run(tc.pattern, func(t *testing.T) {
+			matches, err := Glob(ctx, f, tc.pattern)
+			require.NoError(t, err)
+			assert.Equal(t, tc.matches, matches)
+		})
+	}
+}
+
+func TestGlobInvalidPattern(t *testing.T) {
+	f, err := NewLocalClient(t.TempDir())
+	require.NoError(t, err)
+
+	_, err = Glob(context.Background(), f, "logs/[")
+	assert.ErrorContains(t, err, `invalid pattern "logs/["`)
+}
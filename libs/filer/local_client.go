@@ -3,11 +3,13 @@ package filer
 import (
 	"context"
 	"errors"
+	"fmt"
 	"io"
 	"io/fs"
 	"os"
 	"path/filepath"
 	"slices"
+	"syscall"
 )
 
 // LocalClient implements the [Filer] interface for the local filesystem.
@@ -173,3 +175,53 @@ func (w *LocalClient) Stat(ctx context.Context, name string) (fs.FileInfo, error
 	}
 	return stat, err
 }
+
+func (w *LocalClient) Rename(ctx context.Context, oldName, newName string) error {
+	oldPath, err := w.root.Join(oldName)
+	if err != nil {
+		return err
+	}
+
+	newPath, err := w.root.Join(newName)
+	if err != nil {
+		return err
+	}
+
+	_, err = os.Lstat(oldPath)
+	if errors.Is(err, fs.ErrNotExist) {
+		return FileDoesNotExistError{path: oldPath}
+	}
+	if err != nil {
+		return err
+	}
+
+	// Unlike [os.Rename], don't replace an existing file.
+	_, err = os.Lstat(newPath)
+	if err == nil {
+		return FileAlreadyExistsError{path: newPath}
+	}
+
+	err = os.Rename(oldPath, newPath)
+	if errors.Is(err, syscall.EXDEV) {
+		return fmt.Errorf("cannot rename %s to %s across file systems: %w", oldPath, newPath, errors.ErrUnsupported)
+	}
+	if errors.Is(err, fs.ErrNotExist) {
+		return NoSuchDirectoryError{path: filepath.Dir(newPath)}
+	}
+	return err
+}
+
+func (w *LocalClient) ReadRange(ctx context.Context, name string, offset int64) (io.ReadCloser, error) {
+	r, err := w.Read(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	f := r.(*os.File)
+	_, err = f.Seek(offset, io.SeekStart)
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	return f, nil
+}
@@ -0,0 +1,54 @@
+package filer
+
+import (
+	"context"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalClientRename(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "a"), []byte("a"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "b"), []byte("b"), 0o644))
+
+	f, err := NewLocalClient(dir)
+	require.NoError(t, err)
+	r := f.(Renamer)
+
+	require.NoError(t, r.Rename(ctx, "a", "c"))
+	assert.NoFileExists(t, filepath.Join(dir, "a"))
+	assert.FileExists(t, filepath.Join(dir, "c"))
+
+	err = r.Rename(ctx, "c", "b")
+	assert.ErrorIs(t, err, fs.ErrExist)
+
+	err = r.Rename(ctx, "a", "d")
+	assert.ErrorIs(t, err, fs.ErrNotExist)
+
+	err = r.Rename(ctx, "c", "missing/d")
+	assert.ErrorIs(t, err, fs.ErrNotExist)
+}
+
+func TestLocalClientReadRange(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "a"), []byte("hello world"), 0o644))
+
+	f, err := NewLocalClient(dir)
+	require.NoError(t, err)
+
+	r, err := f.(RangeReader).ReadRange(ctx, "a", 6)
+	require.NoError(t, err)
+	defer r.Close()
+
+	b, err := io.ReadAll(r)
+	require.NoError(t, err)
+	assert.Equal(t, "world", string(b))
+}
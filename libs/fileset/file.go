@@ -58,6 +58,11 @@ func NewSourceFile(root vfs.Path, entry fs.DirEntry, relative string) File {
 	}
 }
 
+// Open opens the file for reading.
+func (f File) Open() (fs.File, error) {
+	return f.root.Open(f.Relative)
+}
+
 func (f File) Modified() (ts time.Time) {
 	info, err := f.entry.Info()
 	if err != nil {
@@ -1,11 +1,14 @@
 package fileset
 
 import (
+	"errors"
 	"fmt"
 	"io/fs"
+	"os"
 	pathlib "path"
 	"path/filepath"
 	"slices"
+	"strings"
 
 	"github.com/databricks/cli/libs/vfs"
 )
@@ -132,3 +135,81 @@ func (w *FileSet) recursiveListFiles(path string, seen map[string]struct{}) (out
 	})
 	return
 }
+
+// File returns the file at the given path if it is part of the fileset.
+// The path is relative to the root of the fileset. The second return value is false if
+// the file does not exist, is not a regular file, is not under one of the configured paths,
+// or is ignored by the [Ignorer] (either directly or through one of its parent directories).
+func (w *FileSet) File(name string) (File, bool, error) {
+	name = pathlib.Clean(filepath.ToSlash(name))
+	if !w.contains(name) {
+		return File{}, false, nil
+	}
+
+	// Use Lstat to skip symlinks, same as the recursive listing does.
+	info, err := os.Lstat(filepath.Join(w.root.Native(), filepath.FromSlash(name)))
+	if errors.Is(err, fs.ErrNotExist) {
+		return File{}, false, nil
+	}
+	if err != nil {
+		return File{}, false, err
+	}
+	if !info.Mode().IsRegular() {
+		return File{}, false, nil
+	}
+
+	ok, err := w.Matches(name)
+	if err != nil || !ok {
+		return File{}, false, err
+	}
+
+	return NewFile(w.root, fs.FileInfoToDirEntry(info), name), true, nil
+}
+
+// Matches returns true if a file at the given path is part of the fileset, whether or not it exists.
+// The path is relative to the root of the fileset. It applies the same rules as [FileSet.File]
+// except for the checks on the file itself.
+func (w *FileSet) Matches(name string) (bool, error) {
+	name = pathlib.Clean(filepath.ToSlash(name))
+	if !w.contains(name) {
+		return false, nil
+	}
+
+	// Check parent directories from the root down, same as the recursive listing does.
+	var dirs []string
+	for dir := pathlib.Dir(name); ; dir = pathlib.Dir(dir) {
+		dirs = append(dirs, dir)
+		if dir == "." {
+			break
+		}
+	}
+	for _, dir := range slices.Backward(dirs) {
+		ign, err := w.ignore.IgnoreDirectory(dir)
+		if err != nil {
+			return false, fmt.Errorf("cannot check if %s should be ignored: %w", dir, err)
+		}
+		if ign {
+			return false, nil
+		}
+	}
+
+	ign, err := w.ignore.IgnoreFile(name)
+	if err != nil {
+		return false, fmt.Errorf("cannot check if %s should be ignored: %w", name, err)
+	}
+	if ign {
+		return false, nil
+	}
+
+	return true, nil
+}
+
+// contains returns true if the path is one of the configured paths or is located under one.
+func (w *FileSet) contains(name string) bool {
+	for _, p := range w.paths {
+		if p == "." || p == name || strings.HasPrefix(name, p+"/") {
+			return true
+		}
+	}
+	return false
+}
@@ -97,6 +97,68 @@ func TestFileSet_IgnoreFile(t *testing.T) {
 	assert.Equal(t, "dir2/b", files[2].Relative)
 }
 
+func TestFileSet_File(t *testing.T) {
+	fs := New(vfs.MustNew("testdata"), []string{"dir1", "dir2/a", "dir3"})
+	fs.SetIgnorer(testIgnorer{file: []string{"dir1/b"}})
+
+	f, ok, err := fs.File("dir1/a")
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.True(t, ok)
+	assert.Equal(t, "dir1/a", f.Relative)
+
+	for _, name := range []string{
+		// Ignored file.
+		"dir1/b",
+		// Not under one of the paths.
+		"dir2/b",
+		// Does not exist.
+		"dir1/c",
+		// Not a regular file.
+		"dir1",
+		"dir3/a",
+	} {
+		_, ok, err = fs.File(name)
+		assert.NoError(t, err)
+		assert.False(t, ok, name)
+	}
+}
+
+func TestFileSet_FileIgnoreDir(t *testing.T) {
+	fs := New(vfs.MustNew("testdata"))
+	fs.SetIgnorer(testIgnorer{dir: []string{"dir1"}})
+
+	_, ok, err := fs.File("dir1/a")
+	assert.NoError(t, err)
+	assert.False(t, ok)
+
+	_, ok, err = fs.File("dir2/a")
+	assert.NoError(t, err)
+	assert.True(t, ok)
+}
+
+func TestFileSet_Matches(t *testing.T) {
+	fs := New(vfs.MustNew("testdata"), []string{"dir1", "dir2/a"})
+	fs.SetIgnorer(testIgnorer{dir: []string{"dir1/sub"}, file: []string{"dir1/b"}})
+
+	for name, expected := range map[string]bool{
+		"dir1/a": true,
+		// Does not exist.
+		"dir1/c": true,
+		// Ignored file.
+		"dir1/b": false,
+		// In an ignored directory.
+		"dir1/sub/a": false,
+		// Not under one of the paths.
+		"dir2/b": false,
+	} {
+		ok, err := fs.Matches(name)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, ok, name)
+	}
+}
+
 type testIgnorer struct {
 	// dir is a list of directories to ignore. Strings are compared verbatim.
 	dir []string
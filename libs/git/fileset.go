@@ -43,3 +43,17 @@ func (f *FileSet) Files() ([]fileset.File, error) {
 	f.view.repo.taintIgnoreRules()
 	return f.fileset.Files()
 }
+
+// File returns the file at the given path if it is part of the fileset.
+// See [fileset.FileSet.File] for details.
+func (f *FileSet) File(name string) (fileset.File, bool, error) {
+	f.view.repo.taintIgnoreRules()
+	return f.fileset.File(name)
+}
+
+// Matches returns true if a file at the given path is part of the fileset, whether or not it exists.
+// See [fileset.FileSet.Matches] for details.
+func (f *FileSet) Matches(name string) (bool, error) {
+	f.view.repo.taintIgnoreRules()
+	return f.fileset.Matches(name)
+}
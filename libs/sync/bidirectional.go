@@ -0,0 +1,328 @@
+package sync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"maps"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/databricks/cli/libs/fileset"
+	"github.com/databricks/cli/libs/log"
+	"github.com/databricks/cli/libs/utils"
+	"github.com/databricks/databricks-sdk-go/service/workspace"
+)
+
+// conflictSuffix is appended to the local name of a file that was modified both
+// locally and remotely to store the remote version next to the local one.
+//
+// The file is not synchronized in either direction for as long as this file exists.
+// Remove it after merging the changes into the local file to upload the result.
+const conflictSuffix = ".remote"
+
+// withoutConflictFiles returns the files that are not conflict files.
+func withoutConflictFiles(files []fileset.File) []fileset.File {
+	out := make([]fileset.File, 0, len(files))
+	for _, f := range files {
+		if !strings.HasSuffix(f.Relative, conflictSuffix) {
+			out = append(out, f)
+		}
+	}
+	return out
+}
+
+// listRemote returns the files and notebooks under the remote path, keyed by their remote name.
+func (s *Sync) listRemote(ctx context.Context) (map[string]workspace.ObjectInfo, error) {
+	out := make(map[string]workspace.ObjectInfo)
+
+	var walk func(dir string) error
+	walk = func(dir string) error {
+		entries, err := s.filer.ReadDir(ctx, dir)
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		for _, entry := range entries {
+			name := path.Join(dir, entry.Name())
+			if entry.IsDir() {
+				err = walk(name)
+				if err != nil {
+					return err
+				}
+				continue
+			}
+
+			info, err := entry.Info()
+			if err != nil {
+				return err
+			}
+			oi, ok := info.Sys().(workspace.ObjectInfo)
+			if !ok {
+				// Other filers only store regular files.
+				oi = workspace.ObjectInfo{
+					ObjectType: workspace.ObjectTypeFile,
+					ModifiedAt: info.ModTime().UnixMilli(),
+				}
+			}
+			if oi.ObjectType == workspace.ObjectTypeFile || oi.ObjectType == workspace.ObjectTypeNotebook {
+				out[name] = oi
+			}
+		}
+		return nil
+	}
+
+	err := walk(".")
+	if err != nil {
+		return nil, fmt.Errorf("cannot list remote files: %w", err)
+	}
+	return out, nil
+}
+
+// localNameForNotebook returns the local name for a notebook that does not exist locally.
+func localNameForNotebook(remoteName string, language workspace.Language) string {
+	switch language {
+	case workspace.LanguagePython:
+		return remoteName + ".py"
+	case workspace.LanguageSql:
+		return remoteName + ".sql"
+	case workspace.LanguageScala:
+		return remoteName + ".scala"
+	case workspace.LanguageR:
+		return remoteName + ".r"
+	default:
+		return remoteName
+	}
+}
+
+// pullRemoteChanges downloads files that were modified in the workspace since the last sync
+// and removes them from the diff. The snapshot state is updated to include the downloaded files.
+//
+// A file that was modified both locally and remotely is a conflict. Its remote version is written
+// next to it with the [conflictSuffix] and the local file is not uploaded until that file is removed.
+//
+// Returns the local names of the downloaded files.
+func (s *Sync) pullRemoteChanges(ctx context.Context, before *SnapshotState, d *diff) ([]string, error) {
+	remote, err := s.listRemote(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	after := s.snapshot.SnapshotState
+	var pulled []string
+
+	for _, remoteName := range utils.SortedKeys(remote) {
+		oi := remote[remoteName]
+		modTime := time.UnixMilli(oi.ModifiedAt)
+
+		localName, tracked := before.RemoteToLocalNames[remoteName]
+		prevModTime, seen := before.RemoteModifiedTimes[remoteName]
+
+		// Files that were synced before bidirectional sync was first used are assumed to be in sync.
+		if tracked && !seen {
+			after.RemoteModifiedTimes[remoteName] = modTime
+			continue
+		}
+
+		// Not modified since the last sync.
+		if seen && !modTime.After(prevModTime) {
+			continue
+		}
+
+		if !tracked {
+			localName = remoteName
+			if oi.ObjectType == workspace.ObjectTypeNotebook {
+				localName = localNameForNotebook(remoteName, oi.Language)
+			}
+		}
+
+		// Remote files are subject to the same include and exclude rules as local files.
+		selected, err := s.selects(localName)
+		if err != nil {
+			return nil, err
+		}
+		if !selected {
+			continue
+		}
+
+		conflict := false
+		if tracked {
+			conflict = slices.Contains(d.put, localName) || slices.Contains(d.delete, remoteName)
+		} else {
+			_, err := os.Lstat(s.localPath(localName))
+			conflict = err == nil
+		}
+
+		if conflict {
+			err = s.pullFile(ctx, remoteName, localName+conflictSuffix, oi)
+			if err != nil {
+				return nil, err
+			}
+			s.notifyProgress(ctx, EventActionConflict, localName, 1.0)
+			after.RemoteModifiedTimes[remoteName] = modTime
+			continue
+		}
+
+		s.notifyProgress(ctx, EventActionPull, localName, 0.0)
+		err = s.pullFile(ctx, remoteName, localName, oi)
+		if err != nil {
+			return nil, err
+		}
+		s.notifyProgress(ctx, EventActionPull, localName, 1.0)
+
+		// Record the downloaded file as if it was uploaded.
+		if !s.DryRun {
+			info, err := os.Stat(s.localPath(localName))
+			if err != nil {
+				return nil, err
+			}
+			if prev, ok := after.LocalToRemoteNames[localName]; ok {
+				delete(after.RemoteToLocalNames, prev)
+			}
+			after.LocalToRemoteNames[localName] = remoteName
+			after.RemoteToLocalNames[remoteName] = localName
+			after.RemoteModifiedTimes[remoteName] = modTime
+			after.LastModifiedTimes[localName] = info.ModTime()
+			delete(after.ContentHashes, localName)
+		}
+
+		d.put = slices.DeleteFunc(d.put, func(name string) bool { return name == localName })
+		pulled = append(pulled, localName)
+	}
+
+	// Files with an unresolved conflict are left as they were at the last sync.
+	// This includes files that were deleted locally since the last sync.
+	localNames := maps.Clone(after.LocalToRemoteNames)
+	maps.Copy(localNames, before.LocalToRemoteNames)
+	for _, localName := range utils.SortedKeys(localNames) {
+		s.keepIfConflicted(localName, before, d)
+	}
+
+	return pulled, nil
+}
+
+// keepIfConflicted reverts the snapshot state of a file with an unresolved conflict to its
+// state at the last sync and removes it from the diff, so that neither side is modified.
+func (s *Sync) keepIfConflicted(localName string, before *SnapshotState, d *diff) {
+	_, err := os.Lstat(s.localPath(localName + conflictSuffix))
+	if err != nil {
+		return
+	}
+
+	after := s.snapshot.SnapshotState
+	if remoteName, ok := after.LocalToRemoteNames[localName]; ok {
+		delete(after.RemoteToLocalNames, remoteName)
+		delete(after.LocalToRemoteNames, localName)
+		delete(after.LastModifiedTimes, localName)
+		delete(after.ContentHashes, localName)
+	}
+
+	if remoteName, ok := before.LocalToRemoteNames[localName]; ok {
+		after.LocalToRemoteNames[localName] = remoteName
+		after.RemoteToLocalNames[remoteName] = localName
+		after.LastModifiedTimes[localName] = before.LastModifiedTimes[localName]
+		if hash, ok := before.ContentHashes[localName]; ok {
+			after.ContentHashes[localName] = hash
+		}
+		if _, ok := after.RemoteModifiedTimes[remoteName]; !ok {
+			after.RemoteModifiedTimes[remoteName] = before.RemoteModifiedTimes[remoteName]
+		}
+		d.delete = slices.DeleteFunc(d.delete, func(name string) bool { return name == remoteName })
+	}
+
+	d.put = slices.DeleteFunc(d.put, func(name string) bool { return name == localName })
+}
+
+// selects returns true if a file with the given local name is synchronized, whether or not it exists locally.
+// It applies the same rules as [Sync.GetFileList].
+func (s *Sync) selects(localName string) (bool, error) {
+	ok, err := s.fileSet.Matches(localName)
+	if err != nil {
+		return false, err
+	}
+	if !ok {
+		ok, err = s.includeFileSet.Matches(localName)
+		if err != nil || !ok {
+			return false, err
+		}
+	}
+
+	excluded, err := s.excludeFileSet.Matches(localName)
+	if err != nil {
+		return false, err
+	}
+	return !excluded, nil
+}
+
+// pullFile downloads the remote file to the local path.
+//
+// Notebooks are downloaded in source format, except when the local file is a Jupyter notebook.
+func (s *Sync) pullFile(ctx context.Context, remoteName, localName string, oi workspace.ObjectInfo) error {
+	if s.DryRun {
+		return nil
+	}
+
+	var r io.ReadCloser
+	var err error
+	if oi.ObjectType == workspace.ObjectTypeNotebook && strings.HasSuffix(strings.TrimSuffix(localName, conflictSuffix), ".ipynb") {
+		r, err = s.WorkspaceClient.Workspace.Download(ctx, path.Join(s.RemotePath, remoteName), workspace.DownloadFormat(workspace.ExportFormatJupyter))
+	} else {
+		r, err = s.filer.Read(ctx, remoteName)
+	}
+	if err != nil {
+		return fmt.Errorf("cannot download %s: %w", remoteName, err)
+	}
+	defer r.Close()
+
+	localPath := s.localPath(localName)
+	err = os.MkdirAll(filepath.Dir(localPath), 0o755)
+	if err != nil {
+		return err
+	}
+
+	f, err := os.Create(localPath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	_, err = io.Copy(f, r)
+	if err != nil {
+		return fmt.Errorf("cannot download %s: %w", remoteName, err)
+	}
+	return f.Close()
+}
+
+// recordRemoteModifiedTime records the modified_at time of a file after it was uploaded,
+// so that the upload is not mistaken for a remote modification by the next sync.
+func (s *Sync) recordRemoteModifiedTime(ctx context.Context, localName string) {
+	s.remoteMu.Lock()
+	remoteName, ok := s.snapshot.LocalToRemoteNames[localName]
+	s.remoteMu.Unlock()
+	if !ok {
+		return
+	}
+
+	info, err := s.filer.Stat(ctx, remoteName)
+	if err != nil {
+		log.Debugf(ctx, "cannot read modification time of %s: %s", remoteName, err)
+		return
+	}
+
+	s.remoteMu.Lock()
+	s.snapshot.RemoteModifiedTimes[remoteName] = info.ModTime()
+	s.remoteMu.Unlock()
+}
+
+func (s *Sync) localPath(localName string) string {
+	return filepath.Join(s.LocalRoot.Native(), filepath.FromSlash(localName))
+}
@@ -0,0 +1,175 @@
+package sync
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/databricks/cli/internal/testutil"
+	"github.com/databricks/cli/libs/filer"
+	"github.com/databricks/cli/libs/fileset"
+	"github.com/databricks/cli/libs/git"
+	"github.com/databricks/cli/libs/vfs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newBidirectionalTestSync(t *testing.T, localDir, remoteDir string) *Sync {
+	root := vfs.MustNew(localDir)
+	fileSet, err := git.NewFileSetAtRoot(root)
+	require.NoError(t, err)
+
+	inc, err := fileset.NewGlobSet(root, []string{})
+	require.NoError(t, err)
+
+	excl, err := fileset.NewGlobSet(root, []string{})
+	require.NoError(t, err)
+
+	opts := defaultOptions(t)
+	opts.LocalRoot = root
+	opts.Bidirectional = true
+
+	snapshot, err := newSnapshot(context.Background(), opts)
+	require.NoError(t, err)
+
+	f, err := filer.NewLocalClient(remoteDir)
+	require.NoError(t, err)
+
+	return &Sync{
+		SyncOptions: opts,
+
+		fileSet:        fileSet,
+		includeFileSet: inc,
+		excludeFileSet: excl,
+		snapshot:       snapshot,
+		filer:          f,
+		notifier:       &NopNotifier{},
+	}
+}
+
+// writeRemote writes a remote file with a modification time in the future,
+// so that it is newer than the modification time recorded by the last sync.
+func writeRemote(t *testing.T, path, content string, offset time.Duration) {
+	testutil.WriteFile(t, path, content)
+	mtime := time.Now().Add(offset)
+	require.NoError(t, os.Chtimes(path, mtime, mtime))
+}
+
+func TestBidirectionalSync(t *testing.T) {
+	ctx := context.Background()
+	localDir := t.TempDir()
+	remoteDir := t.TempDir()
+
+	testutil.WriteFile(t, filepath.Join(localDir, "a.txt"), "local")
+	s := newBidirectionalTestSync(t, localDir, remoteDir)
+
+	// Initial sync uploads local files.
+	_, err := s.RunOnce(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "local", testutil.ReadFile(t, filepath.Join(remoteDir, "a.txt")))
+
+	// Remote modifications and new remote files are downloaded.
+	writeRemote(t, filepath.Join(remoteDir, "a.txt"), "remote", time.Hour)
+	writeRemote(t, filepath.Join(remoteDir, "dir", "b.txt"), "new", time.Hour)
+	_, err = s.RunOnce(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "remote", testutil.ReadFile(t, filepath.Join(localDir, "a.txt")))
+	assert.Equal(t, "new", testutil.ReadFile(t, filepath.Join(localDir, "dir", "b.txt")))
+
+	// Downloaded files are not uploaded again.
+	before := s.snapshot.RemoteModifiedTimes["a.txt"]
+	_, err = s.RunOnce(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, before, s.snapshot.RemoteModifiedTimes["a.txt"])
+
+	// Local modifications are uploaded.
+	testutil.WriteFile(t, filepath.Join(localDir, "dir", "b.txt"), "updated")
+	_, err = s.RunOnce(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "updated", testutil.ReadFile(t, filepath.Join(remoteDir, "dir", "b.txt")))
+}
+
+func TestBidirectionalSyncConflict(t *testing.T) {
+	ctx := context.Background()
+	localDir := t.TempDir()
+	remoteDir := t.TempDir()
+
+	testutil.WriteFile(t, filepath.Join(localDir, "a.txt"), "original")
+	s := newBidirectionalTestSync(t, localDir, remoteDir)
+
+	_, err := s.RunOnce(ctx)
+	require.NoError(t, err)
+
+	// Both sides are modified.
+	testutil.WriteFile(t, filepath.Join(localDir, "a.txt"), "local")
+	writeRemote(t, filepath.Join(remoteDir, "a.txt"), "remote", time.Hour)
+
+	// Neither side is overwritten; the remote version is written next to the local file.
+	for range 2 {
+		_, err = s.RunOnce(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, "local", testutil.ReadFile(t, filepath.Join(localDir, "a.txt")))
+		assert.Equal(t, "remote", testutil.ReadFile(t, filepath.Join(localDir, "a.txt.remote")))
+		assert.Equal(t, "remote", testutil.ReadFile(t, filepath.Join(remoteDir, "a.txt")))
+		assert.NoFileExists(t, filepath.Join(remoteDir, "a.txt.remote"))
+	}
+
+	// The conflict is resolved by removing the remote version; the local file is uploaded.
+	testutil.WriteFile(t, filepath.Join(localDir, "a.txt"), "merged")
+	require.NoError(t, os.Remove(filepath.Join(localDir, "a.txt.remote")))
+	_, err = s.RunOnce(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "merged", testutil.ReadFile(t, filepath.Join(remoteDir, "a.txt")))
+}
+
+func TestBidirectionalSyncConflictWithNewLocalFile(t *testing.T) {
+	ctx := context.Background()
+	localDir := t.TempDir()
+	remoteDir := t.TempDir()
+
+	s := newBidirectionalTestSync(t, localDir, remoteDir)
+	_, err := s.RunOnce(ctx)
+	require.NoError(t, err)
+
+	// The same file is created on both sides.
+	testutil.WriteFile(t, filepath.Join(localDir, "a.txt"), "local")
+	writeRemote(t, filepath.Join(remoteDir, "a.txt"), "remote", time.Hour)
+
+	_, err = s.RunOnce(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "local", testutil.ReadFile(t, filepath.Join(localDir, "a.txt")))
+	assert.Equal(t, "remote", testutil.ReadFile(t, filepath.Join(localDir, "a.txt.remote")))
+	assert.Equal(t, "remote", testutil.ReadFile(t, filepath.Join(remoteDir, "a.txt")))
+}
+
+func TestBidirectionalSyncExclude(t *testing.T) {
+	ctx := context.Background()
+	localDir := t.TempDir()
+	remoteDir := t.TempDir()
+
+	s := newBidirectionalTestSync(t, localDir, remoteDir)
+	excl, err := fileset.NewGlobSet(s.LocalRoot, []string{"*.log", "build/"})
+	require.NoError(t, err)
+	s.excludeFileSet = excl
+
+	_, err = s.RunOnce(ctx)
+	require.NoError(t, err)
+
+	// Remote files that are excluded locally are not downloaded.
+	writeRemote(t, filepath.Join(remoteDir, "a.txt"), "new", time.Hour)
+	writeRemote(t, filepath.Join(remoteDir, "run.log"), "log", time.Hour)
+	writeRemote(t, filepath.Join(remoteDir, "build", "out.txt"), "out", time.Hour)
+	_, err = s.RunOnce(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "new", testutil.ReadFile(t, filepath.Join(localDir, "a.txt")))
+	assert.NoFileExists(t, filepath.Join(localDir, "run.log"))
+	assert.NoDirExists(t, filepath.Join(localDir, "build"))
+}
+
+func TestLocalNameForNotebook(t *testing.T) {
+	assert.Equal(t, "foo.py", localNameForNotebook("foo", "PYTHON"))
+	assert.Equal(t, "foo.sql", localNameForNotebook("foo", "SQL"))
+	assert.Equal(t, "foo", localNameForNotebook("foo", ""))
+}
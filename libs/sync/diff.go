@@ -74,12 +74,24 @@ func (d *diff) addNewFiles(after, before *SnapshotState) {
 }
 
 // Add operators for files which had their contents updated.
+//
+// A file is considered updated if its mtime changed (in either direction, to account
+// for clock skew) and its content hash differs. If either hash is unknown, for example
+// after loading a v1 snapshot, a changed mtime is enough.
 func (d *diff) addUpdatedFiles(after, before *SnapshotState) {
 	for localName, modTime := range after.LastModifiedTimes {
 		prevModTime, ok := before.LastModifiedTimes[localName]
-		if ok && modTime.After(prevModTime) {
-			d.put = append(d.put, localName)
+		if !ok || modTime.Equal(prevModTime) {
+			continue
+		}
+
+		hash, ok := after.ContentHashes[localName]
+		prevHash, prevOk := before.ContentHashes[localName]
+		if ok && prevOk && hash == prevHash {
+			continue
 		}
+
+		d.put = append(d.put, localName)
 	}
 }
 
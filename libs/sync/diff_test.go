@@ -184,3 +184,52 @@ func TestDiffComputationForUpdatedFiles(t *testing.T) {
 	}
 	assert.Equal(t, expected, computeDiff(after, before))
 }
+
+func TestDiffComputationForUpdatedFilesWithContentHashes(t *testing.T) {
+	tick := time.Now()
+	names := map[string]string{
+		"same":    "same",
+		"changed": "changed",
+		"skewed":  "skewed",
+		"nohash":  "nohash",
+	}
+	before := &SnapshotState{
+		LocalToRemoteNames: names,
+		RemoteToLocalNames: names,
+		LastModifiedTimes: map[string]time.Time{
+			"same":    tick,
+			"changed": tick,
+			"skewed":  tick,
+			"nohash":  tick,
+		},
+		ContentHashes: map[string]string{
+			"same":    "a",
+			"changed": "a",
+			"skewed":  "a",
+		},
+	}
+	after := &SnapshotState{
+		LocalToRemoteNames: names,
+		RemoteToLocalNames: names,
+		LastModifiedTimes: map[string]time.Time{
+			// Touched without changing the content.
+			"same": tick.Add(time.Second),
+			// Modified.
+			"changed": tick.Add(time.Second),
+			// Modified with an mtime in the past.
+			"skewed": tick.Add(-time.Second),
+			// Modified; the previous state has no hash (v1 snapshot).
+			"nohash": tick.Add(time.Second),
+		},
+		ContentHashes: map[string]string{
+			"same":    "a",
+			"changed": "b",
+			"skewed":  "b",
+			"nohash":  "b",
+		},
+	}
+
+	d := computeDiff(after, before)
+	assert.ElementsMatch(t, []string{"changed", "skewed", "nohash"}, d.put)
+	assert.Empty(t, d.delete)
+}
@@ -21,6 +21,10 @@ type EventAction string
 const (
 	EventActionPut    = EventAction("put")
 	EventActionDelete = EventAction("delete")
+
+	// Actions of bidirectional sync (see [SyncOptions.Bidirectional]).
+	EventActionPull     = EventAction("pull")
+	EventActionConflict = EventAction("conflict")
 )
 
 type Event interface {
@@ -109,6 +113,10 @@ func (e *EventSyncProgress) String() string {
 		return "Uploaded " + e.Path
 	case EventActionDelete:
 		return "Deleted " + e.Path
+	case EventActionPull:
+		return "Downloaded " + e.Path
+	case EventActionConflict:
+		return fmt.Sprintf("Conflict: %s was modified both locally and remotely; the remote version is written to %s", e.Path, e.Path+conflictSuffix)
 	default:
 		panic("invalid action")
 	}
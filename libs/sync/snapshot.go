@@ -17,14 +17,19 @@ import (
 )
 
 // Bump it up every time a potentially breaking change is made to the snapshot schema
-const LatestSnapshotVersion = "v1"
+const LatestSnapshotVersion = "v2"
+
+// Snapshots of version v1 don't include content hashes. They are loaded as-is
+// and the hashes are computed by the next sync.
+const snapshotVersionV1 = "v1"
 
 // A snapshot is a persistant store of knowledge this CLI has about state of files
-// in the remote repo. We use the last modified times (mtime) of files to determine
-// whether a files need to be updated in the remote repo.
+// in the remote repo. We use the last modified times (mtime) and content hashes of
+// files to determine whether a files need to be updated in the remote repo.
 //
 // 1. Any stale files in the remote repo are updated. That is if the last modified
-// time recorded in the snapshot is less than the actual last modified time of the file
+// time recorded in the snapshot differs from the actual last modified time of the file
+// and the content hash recorded in the snapshot differs from the hash of its content
 //
 // 2. Any files present in snapshot but absent locally are deleted from remote path
 //
@@ -113,9 +118,11 @@ func newSnapshot(ctx context.Context, opts *SyncOptions) (*Snapshot, error) {
 		Host:       opts.Host,
 		RemotePath: opts.RemotePath,
 		SnapshotState: &SnapshotState{
-			LastModifiedTimes:  make(map[string]time.Time),
-			LocalToRemoteNames: make(map[string]string),
-			RemoteToLocalNames: make(map[string]string),
+			LastModifiedTimes:   make(map[string]time.Time),
+			LocalToRemoteNames:  make(map[string]string),
+			RemoteToLocalNames:  make(map[string]string),
+			ContentHashes:       make(map[string]string),
+			RemoteModifiedTimes: make(map[string]time.Time),
 		},
 	}, nil
 }
@@ -162,7 +169,7 @@ func loadOrNewSnapshot(ctx context.Context, opts *SyncOptions) (*Snapshot, error
 	}
 
 	// invalidate old snapshot with schema versions
-	if fromDisk.Version != LatestSnapshotVersion {
+	if fromDisk.Version != LatestSnapshotVersion && fromDisk.Version != snapshotVersionV1 {
 		log.Warnf(ctx, "Did not load existing snapshot because its version is %s while the latest version is %s", snapshot.Version, LatestSnapshotVersion)
 		return newSnapshot(ctx, opts)
 	}
@@ -178,6 +185,11 @@ func loadOrNewSnapshot(ctx context.Context, opts *SyncOptions) (*Snapshot, error
 	// CLI version (<= v0.220.0), it may contain backslashes.
 	snapshot.SnapshotState = snapshot.ToSlash()
 
+	if snapshot.Version == snapshotVersionV1 {
+		log.Debugf(ctx, "Migrating snapshot from version %s to %s", snapshot.Version, LatestSnapshotVersion)
+		snapshot.Version = LatestSnapshotVersion
+	}
+
 	snapshot.New = false
 	return snapshot, nil
 }
@@ -193,6 +205,16 @@ func (s *Snapshot) diff(ctx context.Context, all []fileset.File) (diff, error) {
 		return diff{}, fmt.Errorf("error parsing existing sync state. Please delete your existing sync snapshot file (%s) and retry: %w", s.snapshotPath, err)
 	}
 
+	// Hashes are only computed for files with a changed mtime.
+	targetState.computeContentHashes(all, currentState)
+
+	// Remote modified times are carried over for files that still exist.
+	for remoteName, modTime := range currentState.RemoteModifiedTimes {
+		if _, ok := targetState.RemoteToLocalNames[remoteName]; ok {
+			targetState.RemoteModifiedTimes[remoteName] = modTime
+		}
+	}
+
 	// Compute diff to apply to get from current state to new target state.
 	diff := computeDiff(targetState, currentState)
 
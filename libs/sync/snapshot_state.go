@@ -1,7 +1,11 @@
 package sync
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"io"
+	"maps"
 	"path"
 	"path/filepath"
 	"strings"
@@ -25,14 +29,25 @@ type SnapshotState struct {
 	// Inverse of LocalToRemoteNames. Together they form a 1:1 mapping where all
 	// the remote names and local names are unique.
 	RemoteToLocalNames map[string]string `json:"remote_to_local_names"`
+
+	// Map of local file names to the SHA-256 hash of their content. Files found to
+	// have a different mtime are only synced if their content hash differs as well.
+	// Snapshots of version v1 don't include hashes; they are filled in by the next sync.
+	ContentHashes map[string]string `json:"content_hashes"`
+
+	// Map of remote file names to their modified_at time in the workspace at the
+	// time of the last sync. Only recorded by bidirectional sync to detect remote changes.
+	RemoteModifiedTimes map[string]time.Time `json:"remote_modified_times"`
 }
 
 // Convert an array of files on the local file system to a SnapshotState representation.
 func NewSnapshotState(localFiles []fileset.File) (*SnapshotState, error) {
 	fs := &SnapshotState{
-		LastModifiedTimes:  make(map[string]time.Time),
-		LocalToRemoteNames: make(map[string]string),
-		RemoteToLocalNames: make(map[string]string),
+		LastModifiedTimes:   make(map[string]time.Time),
+		LocalToRemoteNames:  make(map[string]string),
+		RemoteToLocalNames:  make(map[string]string),
+		ContentHashes:       make(map[string]string),
+		RemoteModifiedTimes: make(map[string]time.Time),
 	}
 
 	// Expect no files to have a duplicate entry in the input array.
@@ -77,6 +92,47 @@ func (fs *SnapshotState) ResetLastModifiedTimes() {
 	for k := range fs.LastModifiedTimes {
 		fs.LastModifiedTimes[k] = time.Unix(0, 0)
 	}
+	clear(fs.ContentHashes)
+}
+
+// computeContentHashes records the content hash of the files in this state.
+// The hash of a file with the same mtime as in the previous state is carried over,
+// so that only files that were modified (or have no hash yet) are read.
+func (fs *SnapshotState) computeContentHashes(localFiles []fileset.File, before *SnapshotState) {
+	for _, f := range localFiles {
+		modTime, ok := fs.LastModifiedTimes[f.Relative]
+		if !ok {
+			continue
+		}
+
+		prevHash, ok := before.ContentHashes[f.Relative]
+		if ok && modTime.Equal(before.LastModifiedTimes[f.Relative]) {
+			fs.ContentHashes[f.Relative] = prevHash
+			continue
+		}
+
+		hash, err := contentHash(f)
+		if err != nil {
+			// Without a hash, the file is synced if its mtime changed.
+			continue
+		}
+		fs.ContentHashes[f.Relative] = hash
+	}
+}
+
+func contentHash(f fileset.File) (string, error) {
+	r, err := f.Open()
+	if err != nil {
+		return "", err
+	}
+	defer r.Close()
+
+	h := sha256.New()
+	_, err = io.Copy(h, r)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
 }
 
 // Consistency checks for the sync files state representation. These are invariants
@@ -124,9 +180,11 @@ func (fs *SnapshotState) validate() error {
 // are slash-separated. Returns a new snapshot state.
 func (fs SnapshotState) ToSlash() *SnapshotState {
 	new := SnapshotState{
-		LastModifiedTimes:  make(map[string]time.Time),
-		LocalToRemoteNames: make(map[string]string),
-		RemoteToLocalNames: make(map[string]string),
+		LastModifiedTimes:   make(map[string]time.Time),
+		LocalToRemoteNames:  make(map[string]string),
+		RemoteToLocalNames:  make(map[string]string),
+		ContentHashes:       make(map[string]string),
+		RemoteModifiedTimes: make(map[string]time.Time),
 	}
 
 	// Keys are local paths.
@@ -144,5 +202,13 @@ func (fs SnapshotState) ToSlash() *SnapshotState {
 		new.RemoteToLocalNames[k] = filepath.ToSlash(v)
 	}
 
+	// Keys are local paths.
+	for k, v := range fs.ContentHashes {
+		new.ContentHashes[filepath.ToSlash(k)] = v
+	}
+
+	// Keys are remote paths.
+	maps.Copy(new.RemoteModifiedTimes, fs.RemoteModifiedTimes)
+
 	return &new
 }
@@ -89,6 +89,53 @@ func TestDiff(t *testing.T) {
 	assert.Equal(t, map[string]string{"world.txt": "world.txt"}, state.RemoteToLocalNames)
 }
 
+func TestDiffContentHashes(t *testing.T) {
+	ctx := context.Background()
+
+	projectDir := t.TempDir()
+	fileSet, err := git.NewFileSetAtRoot(vfs.MustNew(projectDir))
+	require.NoError(t, err)
+	state := Snapshot{
+		SnapshotState: &SnapshotState{
+			LastModifiedTimes:  make(map[string]time.Time),
+			LocalToRemoteNames: make(map[string]string),
+			RemoteToLocalNames: make(map[string]string),
+		},
+	}
+
+	helloPath := filepath.Join(projectDir, "hello.txt")
+	f1 := testfile.CreateFile(t, helloPath)
+	f1.Overwrite(t, "hello")
+	defer f1.Close(t)
+
+	files, err := fileSet.Files()
+	require.NoError(t, err)
+	change, err := state.diff(ctx, files)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"hello.txt"}, change.put)
+	assert.Equal(t, "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824", state.ContentHashes["hello.txt"])
+
+	// Touching the file without changing its content doesn't put it.
+	future := time.Now().Add(time.Hour)
+	require.NoError(t, os.Chtimes(helloPath, future, future))
+	files, err = fileSet.Files()
+	require.NoError(t, err)
+	change, err = state.diff(ctx, files)
+	require.NoError(t, err)
+	assert.Empty(t, change.put)
+	assert.Equal(t, future.Unix(), state.LastModifiedTimes["hello.txt"].Unix())
+
+	// Changing the content with an mtime in the past puts it.
+	f1.Overwrite(t, "world")
+	past := time.Now().Add(-time.Hour)
+	require.NoError(t, os.Chtimes(helloPath, past, past))
+	files, err = fileSet.Files()
+	require.NoError(t, err)
+	change, err = state.diff(ctx, files)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"hello.txt"}, change.put)
+}
+
 func TestSymlinkDiff(t *testing.T) {
 	ctx := context.Background()
 
@@ -384,6 +431,38 @@ func TestNoVersionSnapshotInvalidation(t *testing.T) {
 	assert.True(t, snapshot.New)
 }
 
+func TestV1SnapshotGetsMigrated(t *testing.T) {
+	v1Snapshot := `{
+		"version": "v1",
+		"host": "www.foobar.com",
+		"remote_path": "/Repos/foo/bar",
+		"last_modified_times": {
+			"foo.txt": "2024-01-01T00:00:00Z"
+		},
+		"local_to_remote_names": {
+			"foo.txt": "foo.txt"
+		},
+		"remote_to_local_names": {
+			"foo.txt": "foo.txt"
+		}
+	}`
+
+	opts := defaultOptions(t)
+	snapshotPath, err := SnapshotPath(opts)
+	require.NoError(t, err)
+	snapshotFile := testfile.CreateFile(t, snapshotPath)
+	snapshotFile.Overwrite(t, v1Snapshot)
+	snapshotFile.Close(t)
+
+	// assert snapshot gets loaded and upgraded to the latest version
+	snapshot, err := loadOrNewSnapshot(context.Background(), opts)
+	require.NoError(t, err)
+	assert.False(t, snapshot.New)
+	assert.Equal(t, LatestSnapshotVersion, snapshot.Version)
+	assert.Equal(t, map[string]string{"foo.txt": "foo.txt"}, snapshot.LocalToRemoteNames)
+	assert.Empty(t, snapshot.ContentHashes)
+}
+
 func TestLatestVersionSnapshotGetsLoaded(t *testing.T) {
 	latestVersionSnapshot := fmt.Sprintf(`{
 			"version": "%s",
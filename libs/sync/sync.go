@@ -34,6 +34,14 @@ type SyncOptions struct {
 
 	PollInterval time.Duration
 
+	// WatchMode determines how [Sync.RunContinuous] detects changes.
+	// Defaults to [WatchModeNotify].
+	WatchMode WatchMode
+
+	// DebounceInterval is the time to wait for more file system notifications
+	// before synchronizing a batch of changes. Defaults to 100ms.
+	DebounceInterval time.Duration
+
 	WorkspaceClient *databricks.WorkspaceClient
 
 	CurrentUser *iam.User
@@ -43,6 +51,10 @@ type SyncOptions struct {
 	OutputHandler OutputHandler
 
 	DryRun bool
+
+	// Bidirectional also downloads files that were modified in the workspace since the last sync.
+	// Files that were modified on both sides are not overwritten; see [conflictSuffix].
+	Bidirectional bool
 }
 
 type Sync struct {
@@ -59,6 +71,9 @@ type Sync struct {
 	notifier EventNotifier
 	seq      int
 
+	// Guards the snapshot state while files are uploaded in parallel.
+	remoteMu stdsync.Mutex
+
 	// WaitGroup is automatically created when an output handler is provided in the SyncOptions.
 	// Close call is required to ensure the output handler goroutine handles all events in time.
 	outputWaitGroup *stdsync.WaitGroup
@@ -185,32 +200,54 @@ func (s *Sync) RunOnce(ctx context.Context) ([]fileset.File, error) {
 		return files, err
 	}
 
+	_, err = s.syncFiles(ctx, files)
+	return files, err
+}
+
+// syncFiles synchronizes the remote path with the specified list of local files.
+// Returns the local names of the files that were downloaded by bidirectional sync.
+func (s *Sync) syncFiles(ctx context.Context, files []fileset.File) ([]string, error) {
+	if s.Bidirectional {
+		files = withoutConflictFiles(files)
+	}
+
+	before := s.snapshot.SnapshotState
 	change, err := s.snapshot.diff(ctx, files)
 	if err != nil {
-		return files, err
+		return nil, err
+	}
+
+	var pulled []string
+	if s.Bidirectional {
+		pulled, err = s.pullRemoteChanges(ctx, before, &change)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	s.notifyStart(ctx, change)
-	if change.IsEmpty() {
+
+	// Bidirectional sync records remote modification times even if nothing changed locally.
+	if change.IsEmpty() && !s.Bidirectional {
 		s.notifyComplete(ctx, change)
-		return files, nil
+		return pulled, nil
 	}
 
 	err = s.applyDiff(ctx, change)
 	if err != nil {
-		return files, err
+		return nil, err
 	}
 
 	if !s.DryRun {
 		err = s.snapshot.Save(ctx)
 		if err != nil {
 			log.Errorf(ctx, "cannot store snapshot: %s", err)
-			return files, err
+			return nil, err
 		}
 	}
 
 	s.notifyComplete(ctx, change)
-	return files, nil
+	return pulled, nil
 }
 
 func (s *Sync) GetFileList(ctx context.Context) ([]fileset.File, error) {
@@ -247,7 +284,24 @@ func (s *Sync) GetFileList(ctx context.Context) ([]fileset.File, error) {
 	return all.Iter(), nil
 }
 
+// RunContinuous synchronizes local changes until the context is cancelled.
+//
+// Changes are detected with file system notifications unless [WatchModePoll] is configured.
+// If notifications cannot be used, for example because the limit on the number of
+// watched directories is reached, it falls back to polling every [SyncOptions.PollInterval].
 func (s *Sync) RunContinuous(ctx context.Context) error {
+	if s.WatchMode != WatchModePoll {
+		err := s.runWatch(ctx)
+		if !errors.Is(err, errWatchUnavailable) {
+			return err
+		}
+		log.Warnf(ctx, "%s; falling back to polling every %s", err, s.PollInterval)
+	}
+
+	return s.runPoll(ctx)
+}
+
+func (s *Sync) runPoll(ctx context.Context) error {
 	ticker := time.NewTicker(s.PollInterval)
 	defer ticker.Stop()
 
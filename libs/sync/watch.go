@@ -0,0 +1,375 @@
+package sync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/databricks/cli/libs/fileset"
+	"github.com/databricks/cli/libs/log"
+	"github.com/databricks/cli/libs/utils"
+	"github.com/fsnotify/fsnotify"
+)
+
+// WatchMode determines how [Sync.RunContinuous] detects local changes.
+type WatchMode string
+
+const (
+	// WatchModeNotify uses file system notifications (inotify, kqueue, ReadDirectoryChangesW)
+	// and falls back to polling if they cannot be used.
+	WatchModeNotify WatchMode = "notify"
+
+	// WatchModePoll lists all files every poll interval.
+	WatchModePoll WatchMode = "poll"
+)
+
+func (m *WatchMode) String() string {
+	return string(*m)
+}
+
+func (m *WatchMode) Set(s string) error {
+	switch WatchMode(s) {
+	case WatchModeNotify, WatchModePoll:
+		*m = WatchMode(s)
+	default:
+		return fmt.Errorf("accepted arguments are %s and %s", WatchModeNotify, WatchModePoll)
+	}
+	return nil
+}
+
+func (m *WatchMode) Type() string {
+	return "mode"
+}
+
+const defaultDebounceInterval = 100 * time.Millisecond
+
+// errWatchUnavailable is returned if file system notifications cannot be used.
+var errWatchUnavailable = errors.New("cannot watch the file system for changes")
+
+// isWatchLimitError returns true if the error indicates that the operating system
+// limit on the number of watches or open files is reached.
+func isWatchLimitError(err error) bool {
+	return errors.Is(err, syscall.ENOSPC) || errors.Is(err, syscall.EMFILE) || errors.Is(err, syscall.ENFILE)
+}
+
+// runWatch synchronizes local changes as they are reported by file system notifications.
+//
+// Instead of listing all files on every change, it keeps the list of files from the initial
+// synchronization and only updates the paths it receives notifications for.
+// The complete list is rebuilt if ignore rules change or if notifications may have been missed.
+func (s *Sync) runWatch(ctx context.Context) error {
+	w, err := fsnotify.NewWatcher()
+	if err != nil {
+		return fmt.Errorf("%w: %w", errWatchUnavailable, err)
+	}
+	defer w.Close()
+
+	// Watches are added before the initial synchronization so that no change is missed.
+	err = s.addWatches(w)
+	if err != nil {
+		return err
+	}
+
+	files, err := s.RunOnce(ctx)
+	if err != nil {
+		return err
+	}
+
+	watched := newWatchedFiles(files)
+
+	debounce := s.DebounceInterval
+	if debounce <= 0 {
+		debounce = defaultDebounceInterval
+	}
+
+	timer := time.NewTimer(debounce)
+	timer.Stop()
+	defer timer.Stop()
+
+	changed := make(map[string]struct{})
+	rescan := false
+
+	// Remote changes don't emit notifications; bidirectional sync checks for them every poll interval.
+	var remotePoll <-chan time.Time
+	if s.Bidirectional {
+		ticker := time.NewTicker(s.PollInterval)
+		defer ticker.Stop()
+		remotePoll = ticker.C
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+
+		case event, ok := <-w.Events:
+			if !ok {
+				return errors.New("file system watcher closed unexpectedly")
+			}
+
+			rel, ok := s.relativePath(event.Name)
+			if !ok {
+				continue
+			}
+
+			log.Tracef(ctx, "file system event: %s", event)
+
+			if event.Has(fsnotify.Create) {
+				info, err := os.Lstat(event.Name)
+				if err == nil && info.IsDir() {
+					err = s.addDirectoryWatches(w, rel)
+					if err != nil {
+						return err
+					}
+
+					// Files that were created in the directory before its watch was
+					// added don't emit events, so the directory has to be listed.
+					rescan = true
+				}
+			}
+
+			// Changes to ignore rules may include or exclude any number of files.
+			if path.Base(rel) == ".gitignore" {
+				rescan = true
+			}
+
+			changed[rel] = struct{}{}
+			timer.Reset(debounce)
+
+		case err, ok := <-w.Errors:
+			if !ok {
+				return errors.New("file system watcher closed unexpectedly")
+			}
+			if errors.Is(err, fsnotify.ErrEventOverflow) {
+				log.Debugf(ctx, "file system event queue overflowed, listing all files")
+				rescan = true
+				timer.Reset(debounce)
+				continue
+			}
+			if isWatchLimitError(err) {
+				return fmt.Errorf("%w: %w", errWatchUnavailable, err)
+			}
+			return err
+
+		case <-timer.C:
+			if rescan {
+				// Directories that were ignored before may no longer be.
+				err = s.addWatches(w)
+				if err != nil {
+					return err
+				}
+
+				files, err := s.GetFileList(ctx)
+				if err != nil {
+					return err
+				}
+				watched = newWatchedFiles(files)
+			} else {
+				err = s.updateWatchedFiles(watched, changed)
+				if err != nil {
+					return err
+				}
+			}
+
+			clear(changed)
+			rescan = false
+
+			err = s.syncWatchedFiles(ctx, watched)
+			if err != nil {
+				return err
+			}
+
+		case <-remotePoll:
+			err = s.syncWatchedFiles(ctx, watched)
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// syncWatchedFiles synchronizes the watched files and adds the files that were downloaded.
+// Their file system events may only be received after the next synchronization.
+func (s *Sync) syncWatchedFiles(ctx context.Context, watched watchedFiles) error {
+	pulled, err := s.syncFiles(ctx, watched.list())
+	if err != nil {
+		return err
+	}
+
+	changed := make(map[string]struct{}, len(pulled))
+	for _, name := range pulled {
+		changed[name] = struct{}{}
+	}
+	return s.updateWatchedFiles(watched, changed)
+}
+
+// relativePath converts a native path from a file system event to a path relative to the local root.
+func (s *Sync) relativePath(name string) (string, bool) {
+	rel, err := filepath.Rel(s.LocalRoot.Native(), name)
+	if err != nil {
+		return "", false
+	}
+	rel = filepath.ToSlash(rel)
+	if rel == "." || rel == ".." || strings.HasPrefix(rel, "../") {
+		return "", false
+	}
+	return rel, true
+}
+
+// addWatches adds watches for all directories that may contain synchronized files.
+func (s *Sync) addWatches(w *fsnotify.Watcher) error {
+	paths := s.Paths
+	if len(paths) == 0 {
+		paths = []string{"."}
+	}
+
+	for _, p := range paths {
+		p = path.Clean(filepath.ToSlash(p))
+		info, err := fs.Stat(s.LocalRoot, p)
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		// A path may refer to a single file; watch its directory to see it change.
+		if !info.IsDir() {
+			p = path.Dir(p)
+		}
+
+		err = s.addDirectoryWatches(w, p)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// addDirectoryWatches adds watches for the directory and all its subdirectories.
+// Directories that are ignored are skipped, unless include patterns are configured,
+// because those may match files in ignored directories.
+func (s *Sync) addDirectoryWatches(w *fsnotify.Watcher, dir string) error {
+	err := fs.WalkDir(s.LocalRoot, dir, func(name string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.IsDir() {
+			return nil
+		}
+		if d.Name() == ".git" {
+			return fs.SkipDir
+		}
+
+		if len(s.Include) == 0 {
+			ign, err := s.fileSet.IgnoreDirectory(name)
+			if err != nil {
+				return fmt.Errorf("cannot check if %s should be ignored: %w", name, err)
+			}
+			if ign {
+				return fs.SkipDir
+			}
+		}
+
+		err = w.Add(filepath.Join(s.LocalRoot.Native(), filepath.FromSlash(name)))
+		if errors.Is(err, fs.ErrNotExist) {
+			// Removed in the meantime; the removal is reported by the parent directory.
+			return fs.SkipDir
+		}
+		if isWatchLimitError(err) {
+			return fmt.Errorf("%w: watching %s: %w", errWatchUnavailable, name, err)
+		}
+		return err
+	})
+
+	// The directory may have been removed before it could be watched.
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	return err
+}
+
+// updateWatchedFiles refreshes the entries for the paths that file system events were received for.
+func (s *Sync) updateWatchedFiles(watched watchedFiles, changed map[string]struct{}) error {
+	for rel := range changed {
+		f, ok, err := s.lookupFile(rel)
+		if err != nil {
+			return err
+		}
+		if ok {
+			watched[rel] = f
+			continue
+		}
+
+		// Only a removed or renamed directory removes the files it contained;
+		// events for existing directories (e.g. permission changes) don't affect them.
+		_, err = fs.Stat(s.LocalRoot, rel)
+		if errors.Is(err, fs.ErrNotExist) {
+			watched.remove(rel)
+		} else {
+			delete(watched, rel)
+		}
+	}
+	return nil
+}
+
+// lookupFile returns the file at the given path if it is synchronized.
+// It applies the same rules as [Sync.GetFileList] to a single file.
+func (s *Sync) lookupFile(rel string) (fileset.File, bool, error) {
+	f, ok, err := s.fileSet.File(rel)
+	if err != nil {
+		return fileset.File{}, false, err
+	}
+	if !ok {
+		f, ok, err = s.includeFileSet.File(rel)
+		if err != nil || !ok {
+			return fileset.File{}, false, err
+		}
+	}
+
+	_, excluded, err := s.excludeFileSet.File(rel)
+	if err != nil || excluded {
+		return fileset.File{}, false, err
+	}
+
+	return f, true, nil
+}
+
+// watchedFiles is the set of synchronized files in watch mode, keyed by their relative path.
+type watchedFiles map[string]fileset.File
+
+func newWatchedFiles(files []fileset.File) watchedFiles {
+	out := make(watchedFiles, len(files))
+	for _, f := range files {
+		out[f.Relative] = f
+	}
+	return out
+}
+
+// remove removes the file at the given path.
+// If the path was a directory, all files it contained are removed as well.
+func (w watchedFiles) remove(rel string) {
+	delete(w, rel)
+	prefix := rel + "/"
+	for name := range w {
+		if strings.HasPrefix(name, prefix) {
+			delete(w, name)
+		}
+	}
+}
+
+func (w watchedFiles) list() []fileset.File {
+	out := make([]fileset.File, 0, len(w))
+	for _, name := range utils.SortedKeys(w) {
+		out = append(out, w[name])
+	}
+	return out
+}
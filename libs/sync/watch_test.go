@@ -0,0 +1,134 @@
+package sync
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/databricks/cli/internal/testutil"
+	"github.com/databricks/cli/libs/fileset"
+	"github.com/databricks/cli/libs/git"
+	"github.com/databricks/cli/libs/vfs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newWatchTestSync(t *testing.T, dir string, exclude []string) (*Sync, <-chan Event) {
+	root := vfs.MustNew(dir)
+	fileSet, err := git.NewFileSetAtRoot(root)
+	require.NoError(t, err)
+
+	inc, err := fileset.NewGlobSet(root, []string{})
+	require.NoError(t, err)
+
+	excl, err := fileset.NewGlobSet(root, exclude)
+	require.NoError(t, err)
+
+	state, err := NewSnapshotState(nil)
+	require.NoError(t, err)
+
+	ch := make(chan Event, 100)
+	s := &Sync{
+		SyncOptions: &SyncOptions{
+			LocalRoot:        root,
+			DryRun:           true,
+			DebounceInterval: 50 * time.Millisecond,
+		},
+
+		fileSet:        fileSet,
+		includeFileSet: inc,
+		excludeFileSet: excl,
+		snapshot:       &Snapshot{SnapshotState: state},
+		notifier:       &ChannelNotifier{ch},
+	}
+
+	return s, ch
+}
+
+func nextComplete(t *testing.T, ch <-chan Event) *EventSyncComplete {
+	timeout := time.After(10 * time.Second)
+	for {
+		select {
+		case e := <-ch:
+			if complete, ok := e.(*EventSyncComplete); ok {
+				return complete
+			}
+		case <-timeout:
+			require.FailNow(t, "timed out waiting for sync to complete")
+		}
+	}
+}
+
+func TestRunWatch(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	dir := t.TempDir()
+	testutil.Touch(t, dir, "a.txt")
+	testutil.Touch(t, dir, "excluded", "b.txt")
+
+	s, events := newWatchTestSync(t, dir, []string{"excluded"})
+
+	errs := make(chan error, 1)
+	go func() {
+		errs <- s.runWatch(ctx)
+	}()
+
+	// Initial synchronization.
+	e := nextComplete(t, events)
+	assert.Equal(t, []string{"a.txt"}, e.Put)
+
+	// New file in a new directory.
+	testutil.Touch(t, dir, "sub", "c.txt")
+	e = nextComplete(t, events)
+	assert.Equal(t, []string{"sub/c.txt"}, e.Put)
+
+	// New file in an existing directory.
+	testutil.Touch(t, dir, "sub", "d.txt")
+	e = nextComplete(t, events)
+	assert.Equal(t, []string{"sub/d.txt"}, e.Put)
+
+	// Removed directory.
+	require.NoError(t, os.RemoveAll(filepath.Join(dir, "sub")))
+	e = nextComplete(t, events)
+	assert.Equal(t, []string{"sub/c.txt", "sub/d.txt"}, e.Delete)
+
+	// Changes to excluded files are not synchronized; the next event is for a.txt.
+	testutil.WriteFile(t, filepath.Join(dir, "excluded", "b.txt"), "b")
+	require.NoError(t, os.Remove(filepath.Join(dir, "a.txt")))
+	e = nextComplete(t, events)
+	assert.Empty(t, e.Put)
+	assert.Equal(t, []string{"a.txt"}, e.Delete)
+
+	cancel()
+	assert.ErrorIs(t, <-errs, context.Canceled)
+}
+
+func TestWatchedFilesRemove(t *testing.T) {
+	w := watchedFiles{
+		"a":     fileset.File{Relative: "a"},
+		"a/b":   fileset.File{Relative: "a/b"},
+		"a/c/d": fileset.File{Relative: "a/c/d"},
+		"ab":    fileset.File{Relative: "ab"},
+	}
+
+	w.remove("a")
+	assert.Equal(t, []fileset.File{{Relative: "ab"}}, w.list())
+}
+
+func TestRelativePath(t *testing.T) {
+	dir := t.TempDir()
+	s := &Sync{SyncOptions: &SyncOptions{LocalRoot: vfs.MustNew(dir)}}
+
+	rel, ok := s.relativePath(filepath.Join(dir, "a", "b.txt"))
+	assert.True(t, ok)
+	assert.Equal(t, "a/b.txt", rel)
+
+	_, ok = s.relativePath(dir)
+	assert.False(t, ok)
+
+	_, ok = s.relativePath(filepath.Dir(dir))
+	assert.False(t, ok)
+}
@@ -76,6 +76,10 @@ func (s *Sync) applyPut(ctx context.Context, localName string) error {
 		if err != nil {
 			return err
 		}
+
+		if s.Bidirectional {
+			s.recordRemoteModifiedTime(ctx, localName)
+		}
 	}
 
 	s.notifyProgress(ctx, EventActionPut, localName, 1.0)
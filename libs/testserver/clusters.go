@@ -0,0 +1,113 @@
+package testserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/databricks/databricks-sdk-go/service/compute"
+	"github.com/google/uuid"
+)
+
+func (s *FakeWorkspace) ClustersCreate(req Request) Response {
+	var request compute.ClusterDetails
+
+	if err := json.Unmarshal(req.Body, &request); err != nil {
+		return Response{
+			Body:       fmt.Sprintf("internal error: %s", err),
+			StatusCode: http.StatusInternalServerError,
+		}
+	}
+
+	defer s.LockUnlock()()
+
+	clusterId := uuid.New().String()
+	request.ClusterId = clusterId
+	request.State = compute.StateRunning
+	s.Clusters[clusterId] = request
+
+	return Response{
+		Body: compute.CreateClusterResponse{
+			ClusterId: clusterId,
+		},
+	}
+}
+
+func (s *FakeWorkspace) ClustersEdit(req Request) Response {
+	var request compute.ClusterDetails
+
+	if err := json.Unmarshal(req.Body, &request); err != nil {
+		return Response{
+			Body:       fmt.Sprintf("internal error: %s", err),
+			StatusCode: http.StatusInternalServerError,
+		}
+	}
+
+	defer s.LockUnlock()()
+
+	existing, ok := s.Clusters[request.ClusterId]
+	if !ok {
+		return Response{
+			StatusCode: 404,
+		}
+	}
+
+	// Edit replaces the whole spec; the cluster keeps its state (running clusters are restarted by the backend).
+	request.State = existing.State
+	s.Clusters[request.ClusterId] = request
+
+	return Response{}
+}
+
+func (s *FakeWorkspace) ClustersResize(req Request) Response {
+	var request compute.ResizeCluster
+
+	if err := json.Unmarshal(req.Body, &request); err != nil {
+		return Response{
+			Body:       fmt.Sprintf("internal error: %s", err),
+			StatusCode: http.StatusInternalServerError,
+		}
+	}
+
+	defer s.LockUnlock()()
+
+	cluster, ok := s.Clusters[request.ClusterId]
+	if !ok {
+		return Response{
+			StatusCode: 404,
+		}
+	}
+
+	if cluster.State != compute.StateRunning {
+		return Response{
+			StatusCode: 400,
+			Body: map[string]string{
+				"error_code": "INVALID_STATE",
+				"message":    fmt.Sprintf("Cluster %s is in unexpected state %s.", request.ClusterId, cluster.State),
+			},
+		}
+	}
+
+	cluster.NumWorkers = request.NumWorkers
+	cluster.Autoscale = request.Autoscale
+	s.Clusters[request.ClusterId] = cluster
+
+	return Response{}
+}
+
+func (s *FakeWorkspace) ClustersGet(clusterId string) Response {
+	return MapGet(s, s.Clusters, clusterId)
+}
+
+func (s *FakeWorkspace) ClustersPermanentDelete(req Request) Response {
+	var request compute.PermanentDeleteCluster
+
+	if err := json.Unmarshal(req.Body, &request); err != nil {
+		return Response{
+			Body:       fmt.Sprintf("internal error: %s", err),
+			StatusCode: http.StatusInternalServerError,
+		}
+	}
+
+	return MapDelete(s, s.Clusters, request.ClusterId)
+}
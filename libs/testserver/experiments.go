@@ -0,0 +1,154 @@
+package testserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/databricks/databricks-sdk-go/service/ml"
+)
+
+func experimentNotFound(id string) Response {
+	return Response{
+		StatusCode: 404,
+		Body: map[string]string{
+			"error_code": "RESOURCE_DOES_NOT_EXIST",
+			"message":    fmt.Sprintf("No Experiment with id=%s exists", id),
+		},
+	}
+}
+
+func (s *FakeWorkspace) ExperimentsCreate(req Request) Response {
+	var request ml.CreateExperiment
+
+	if err := json.Unmarshal(req.Body, &request); err != nil {
+		return Response{
+			Body:       fmt.Sprintf("internal error: %s", err),
+			StatusCode: http.StatusInternalServerError,
+		}
+	}
+
+	defer s.LockUnlock()()
+
+	for _, experiment := range s.Experiments {
+		if experiment.Name == request.Name {
+			return Response{
+				StatusCode: 400,
+				Body: map[string]string{
+					"error_code": "RESOURCE_ALREADY_EXISTS",
+					"message":    fmt.Sprintf("Experiment '%s' already exists.", request.Name),
+				},
+			}
+		}
+	}
+
+	experimentId := strconv.FormatInt(s.nextExperimentId, 10)
+	s.nextExperimentId++
+
+	s.Experiments[experimentId] = ml.Experiment{
+		ArtifactLocation: request.ArtifactLocation,
+		ExperimentId:     experimentId,
+		LifecycleStage:   "active",
+		Name:             request.Name,
+		Tags:             request.Tags,
+	}
+
+	return Response{
+		Body: ml.CreateExperimentResponse{
+			ExperimentId: experimentId,
+		},
+	}
+}
+
+func (s *FakeWorkspace) ExperimentsGet(experimentId string) Response {
+	defer s.LockUnlock()()
+
+	experiment, ok := s.Experiments[experimentId]
+	if !ok {
+		return experimentNotFound(experimentId)
+	}
+
+	return Response{
+		Body: ml.GetExperimentResponse{
+			Experiment: &experiment,
+		},
+	}
+}
+
+func (s *FakeWorkspace) ExperimentsUpdate(req Request) Response {
+	var request ml.UpdateExperiment
+
+	if err := json.Unmarshal(req.Body, &request); err != nil {
+		return Response{
+			Body:       fmt.Sprintf("internal error: %s", err),
+			StatusCode: http.StatusInternalServerError,
+		}
+	}
+
+	defer s.LockUnlock()()
+
+	experiment, ok := s.Experiments[request.ExperimentId]
+	if !ok {
+		return experimentNotFound(request.ExperimentId)
+	}
+
+	if request.NewName != "" {
+		experiment.Name = request.NewName
+	}
+
+	s.Experiments[request.ExperimentId] = experiment
+	return Response{}
+}
+
+func (s *FakeWorkspace) ExperimentsSetTag(req Request) Response {
+	var request ml.SetExperimentTag
+
+	if err := json.Unmarshal(req.Body, &request); err != nil {
+		return Response{
+			Body:       fmt.Sprintf("internal error: %s", err),
+			StatusCode: http.StatusInternalServerError,
+		}
+	}
+
+	defer s.LockUnlock()()
+
+	experiment, ok := s.Experiments[request.ExperimentId]
+	if !ok {
+		return experimentNotFound(request.ExperimentId)
+	}
+
+	experiment.Tags = setExperimentTag(experiment.Tags, request.Key, request.Value)
+	s.Experiments[request.ExperimentId] = experiment
+	return Response{}
+}
+
+func (s *FakeWorkspace) ExperimentsDelete(req Request) Response {
+	var request ml.DeleteExperiment
+
+	if err := json.Unmarshal(req.Body, &request); err != nil {
+		return Response{
+			Body:       fmt.Sprintf("internal error: %s", err),
+			StatusCode: http.StatusInternalServerError,
+		}
+	}
+
+	defer s.LockUnlock()()
+
+	if _, ok := s.Experiments[request.ExperimentId]; !ok {
+		return experimentNotFound(request.ExperimentId)
+	}
+
+	delete(s.Experiments, request.ExperimentId)
+	return Response{}
+}
+
+func setExperimentTag(tags []ml.ExperimentTag, key, value string) []ml.ExperimentTag {
+	for i := range tags {
+		if tags[i].Key == key {
+			tags[i].Value = value
+			return tags
+		}
+	}
+	return append(tags, ml.ExperimentTag{Key: key, Value: value})
+}
@@ -15,10 +15,13 @@ import (
 
 	"github.com/databricks/databricks-sdk-go/service/apps"
 	"github.com/databricks/databricks-sdk-go/service/catalog"
+	"github.com/databricks/databricks-sdk-go/service/compute"
 	"github.com/databricks/databricks-sdk-go/service/dashboards"
 	"github.com/databricks/databricks-sdk-go/service/iam"
 	"github.com/databricks/databricks-sdk-go/service/jobs"
+	"github.com/databricks/databricks-sdk-go/service/ml"
 	"github.com/databricks/databricks-sdk-go/service/pipelines"
+	"github.com/databricks/databricks-sdk-go/service/serving"
 	"github.com/databricks/databricks-sdk-go/service/sql"
 	"github.com/databricks/databricks-sdk-go/service/workspace"
 )
@@ -75,6 +78,13 @@ type FakeWorkspace struct {
 	Volumes         map[string]catalog.VolumeInfo
 	Dashboards      map[string]dashboards.Dashboard
 	SqlWarehouses   map[string]sql.GetWarehouseResponse
+	Clusters        map[string]compute.ClusterDetails
+
+	nextExperimentId int64
+	Experiments      map[string]ml.Experiment
+	Models           map[string]ml.ModelDatabricks
+	RegisteredModels map[string]catalog.RegisteredModelInfo
+	ServingEndpoints map[string]serving.ServingEndpointDetailed
 
 	Acls map[string][]workspace.AclItem
 
@@ -164,6 +174,12 @@ func NewFakeWorkspace(url, token string) *FakeWorkspace {
 		Volumes:              map[string]catalog.VolumeInfo{},
 		Dashboards:           map[string]dashboards.Dashboard{},
 		SqlWarehouses:        map[string]sql.GetWarehouseResponse{},
+		Clusters:             map[string]compute.ClusterDetails{},
+		nextExperimentId:     1,
+		Experiments:          map[string]ml.Experiment{},
+		Models:               map[string]ml.ModelDatabricks{},
+		RegisteredModels:     map[string]catalog.RegisteredModelInfo{},
+		ServingEndpoints:     map[string]serving.ServingEndpointDetailed{},
 		Repos:                map[string]workspace.RepoInfo{},
 		Acls:                 map[string][]workspace.AclItem{},
 		DatabaseInstances:    map[string]database.DatabaseInstance{},
@@ -399,6 +415,45 @@ func (s *FakeWorkspace) JobsRunNow(jobId int64) Response {
 	}
 }
 
+func (s *FakeWorkspace) JobsSubmit(request jobs.SubmitRun) Response {
+	defer s.LockUnlock()()
+
+	runId := s.nextJobRunId
+	s.nextJobRunId++
+
+	var tasks []jobs.RunTask
+	for _, task := range request.Tasks {
+		taskRunId := s.nextJobRunId
+		s.nextJobRunId++
+		tasks = append(tasks, jobs.RunTask{
+			RunId:   taskRunId,
+			TaskKey: task.TaskKey,
+			State: &jobs.RunState{
+				LifeCycleState: jobs.RunLifeCycleStateTerminated,
+				ResultState:    jobs.RunResultStateSuccess,
+			},
+		})
+	}
+
+	s.JobRuns[runId] = jobs.Run{
+		RunId: runId,
+		State: &jobs.RunState{
+			LifeCycleState: jobs.RunLifeCycleStateRunning,
+			ResultState:    jobs.RunResultStateSuccess,
+		},
+		RunPageUrl: fmt.Sprintf("%s/job/run/%d", s.url, runId),
+		RunType:    jobs.RunTypeSubmitRun,
+		RunName:    request.RunName,
+		Tasks:      tasks,
+	}
+
+	return Response{
+		Body: jobs.SubmitRunResponse{
+			RunId: runId,
+		},
+	}
+}
+
 func (s *FakeWorkspace) JobsGetRun(runId int64) Response {
 	defer s.LockUnlock()()
 
@@ -62,6 +62,26 @@ func AddDefaultHandlers(server *Server) {
 		}
 	})
 
+	server.Handle("GET", "/api/2.1/clusters/get", func(req Request) any {
+		return req.Workspace.ClustersGet(req.URL.Query().Get("cluster_id"))
+	})
+
+	server.Handle("POST", "/api/2.1/clusters/create", func(req Request) any {
+		return req.Workspace.ClustersCreate(req)
+	})
+
+	server.Handle("POST", "/api/2.1/clusters/edit", func(req Request) any {
+		return req.Workspace.ClustersEdit(req)
+	})
+
+	server.Handle("POST", "/api/2.1/clusters/resize", func(req Request) any {
+		return req.Workspace.ClustersResize(req)
+	})
+
+	server.Handle("POST", "/api/2.1/clusters/permanent-delete", func(req Request) any {
+		return req.Workspace.ClustersPermanentDelete(req)
+	})
+
 	server.Handle("GET", "/api/2.0/preview/scim/v2/Me", func(req Request) any {
 		return Response{
 			Headers: map[string][]string{"X-Databricks-Org-Id": {"900800700600"}},
@@ -244,6 +264,23 @@ func AddDefaultHandlers(server *Server) {
 		return req.Workspace.JobsRunNow(request.JobId)
 	})
 
+	server.Handle("POST", "/api/2.2/jobs/runs/submit", func(req Request) any {
+		var request jobs.SubmitRun
+		if err := json.Unmarshal(req.Body, &request); err != nil {
+			return Response{
+				Body:       fmt.Sprintf("internal error: %s", err),
+				StatusCode: 500,
+			}
+		}
+
+		return req.Workspace.JobsSubmit(request)
+	})
+
+	server.Handle("GET", "/api/2.2/jobs/runs/get-output", func(req Request) any {
+		// Runs do not produce any output in the test server.
+		return jobs.RunOutput{}
+	})
+
 	server.Handle("GET", "/api/2.2/jobs/runs/get", func(req Request) any {
 		runId := req.URL.Query().Get("run_id")
 		runIdInt, err := strconv.ParseInt(runId, 10, 64)
@@ -423,6 +460,102 @@ func AddDefaultHandlers(server *Server) {
 		return MapDelete(req.Workspace, req.Workspace.Volumes, req.Vars["full_name"])
 	})
 
+	// Registered models:
+	server.Handle("GET", "/api/2.1/unity-catalog/models/{full_name}", func(req Request) any {
+		return MapGet(req.Workspace, req.Workspace.RegisteredModels, req.Vars["full_name"])
+	})
+
+	server.Handle("POST", "/api/2.1/unity-catalog/models", func(req Request) any {
+		return req.Workspace.RegisteredModelsCreate(req)
+	})
+
+	server.Handle("PATCH", "/api/2.1/unity-catalog/models/{full_name}", func(req Request) any {
+		return req.Workspace.RegisteredModelsUpdate(req, req.Vars["full_name"])
+	})
+
+	server.Handle("DELETE", "/api/2.1/unity-catalog/models/{full_name}", func(req Request) any {
+		return MapDelete(req.Workspace, req.Workspace.RegisteredModels, req.Vars["full_name"])
+	})
+
+	// MLflow experiments:
+	server.Handle("GET", "/api/2.0/mlflow/experiments/get", func(req Request) any {
+		return req.Workspace.ExperimentsGet(req.URL.Query().Get("experiment_id"))
+	})
+
+	server.Handle("POST", "/api/2.0/mlflow/experiments/create", func(req Request) any {
+		return req.Workspace.ExperimentsCreate(req)
+	})
+
+	server.Handle("POST", "/api/2.0/mlflow/experiments/update", func(req Request) any {
+		return req.Workspace.ExperimentsUpdate(req)
+	})
+
+	server.Handle("POST", "/api/2.0/mlflow/experiments/set-experiment-tag", func(req Request) any {
+		return req.Workspace.ExperimentsSetTag(req)
+	})
+
+	server.Handle("POST", "/api/2.0/mlflow/experiments/delete", func(req Request) any {
+		return req.Workspace.ExperimentsDelete(req)
+	})
+
+	// MLflow models (workspace model registry):
+	server.Handle("GET", "/api/2.0/mlflow/databricks/registered-models/get", func(req Request) any {
+		return req.Workspace.ModelsGet(req.URL.Query().Get("name"))
+	})
+
+	server.Handle("POST", "/api/2.0/mlflow/registered-models/create", func(req Request) any {
+		return req.Workspace.ModelsCreate(req)
+	})
+
+	server.Handle("PATCH", "/api/2.0/mlflow/registered-models/update", func(req Request) any {
+		return req.Workspace.ModelsUpdate(req)
+	})
+
+	server.Handle("POST", "/api/2.0/mlflow/registered-models/rename", func(req Request) any {
+		return req.Workspace.ModelsRename(req)
+	})
+
+	server.Handle("POST", "/api/2.0/mlflow/registered-models/set-tag", func(req Request) any {
+		return req.Workspace.ModelsSetTag(req)
+	})
+
+	server.Handle("DELETE", "/api/2.0/mlflow/registered-models/delete-tag", func(req Request) any {
+		return req.Workspace.ModelsDeleteTag(req.URL.Query().Get("name"), req.URL.Query().Get("key"))
+	})
+
+	server.Handle("DELETE", "/api/2.0/mlflow/registered-models/delete", func(req Request) any {
+		return req.Workspace.ModelsDelete(req.URL.Query().Get("name"))
+	})
+
+	// Model serving endpoints:
+	server.Handle("GET", "/api/2.0/serving-endpoints/{name}", func(req Request) any {
+		return req.Workspace.ServingEndpointsGet(req.Vars["name"])
+	})
+
+	server.Handle("POST", "/api/2.0/serving-endpoints", func(req Request) any {
+		return req.Workspace.ServingEndpointsCreate(req)
+	})
+
+	server.Handle("PUT", "/api/2.0/serving-endpoints/{name}/config", func(req Request) any {
+		return req.Workspace.ServingEndpointsUpdateConfig(req, req.Vars["name"])
+	})
+
+	server.Handle("PATCH", "/api/2.0/serving-endpoints/{name}/tags", func(req Request) any {
+		return req.Workspace.ServingEndpointsPatchTags(req, req.Vars["name"])
+	})
+
+	server.Handle("PUT", "/api/2.0/serving-endpoints/{name}/ai-gateway", func(req Request) any {
+		return req.Workspace.ServingEndpointsPutAiGateway(req, req.Vars["name"])
+	})
+
+	server.Handle("PUT", "/api/2.0/serving-endpoints/{name}/rate-limits", func(req Request) any {
+		return req.Workspace.ServingEndpointsPutRateLimits(req, req.Vars["name"])
+	})
+
+	server.Handle("DELETE", "/api/2.0/serving-endpoints/{name}", func(req Request) any {
+		return req.Workspace.ServingEndpointsDelete(req.Vars["name"])
+	})
+
 	// SQL Warehouses:
 	server.Handle("GET", "/api/2.0/sql/warehouses/{warehouse_id}", func(req Request) any {
 		return MapGet(req.Workspace, req.Workspace.SqlWarehouses, req.Vars["warehouse_id"])
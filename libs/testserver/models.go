@@ -0,0 +1,195 @@
+package testserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/databricks/databricks-sdk-go/service/ml"
+	"github.com/google/uuid"
+)
+
+func modelNotFound(name string) Response {
+	return Response{
+		StatusCode: 404,
+		Body: map[string]string{
+			"error_code": "RESOURCE_DOES_NOT_EXIST",
+			"message":    fmt.Sprintf("Registered Model with name=%s not found", name),
+		},
+	}
+}
+
+func (s *FakeWorkspace) ModelsCreate(req Request) Response {
+	var request ml.CreateModelRequest
+
+	if err := json.Unmarshal(req.Body, &request); err != nil {
+		return Response{
+			Body:       fmt.Sprintf("internal error: %s", err),
+			StatusCode: http.StatusInternalServerError,
+		}
+	}
+
+	defer s.LockUnlock()()
+
+	if _, ok := s.Models[request.Name]; ok {
+		return Response{
+			StatusCode: 400,
+			Body: map[string]string{
+				"error_code": "RESOURCE_ALREADY_EXISTS",
+				"message":    fmt.Sprintf("Registered Model (name=%s) already exists.", request.Name),
+			},
+		}
+	}
+
+	s.Models[request.Name] = ml.ModelDatabricks{
+		Description: request.Description,
+		Id:          uuid.New().String(),
+		Name:        request.Name,
+		Tags:        request.Tags,
+		UserId:      s.CurrentUser().UserName,
+	}
+
+	return Response{
+		Body: ml.CreateModelResponse{
+			RegisteredModel: &ml.Model{
+				Description: request.Description,
+				Name:        request.Name,
+				Tags:        request.Tags,
+				UserId:      s.CurrentUser().UserName,
+			},
+		},
+	}
+}
+
+func (s *FakeWorkspace) ModelsGet(name string) Response {
+	defer s.LockUnlock()()
+
+	model, ok := s.Models[name]
+	if !ok {
+		return modelNotFound(name)
+	}
+
+	return Response{
+		Body: ml.GetModelResponse{
+			RegisteredModelDatabricks: &model,
+		},
+	}
+}
+
+func (s *FakeWorkspace) ModelsUpdate(req Request) Response {
+	var request ml.UpdateModelRequest
+
+	if err := json.Unmarshal(req.Body, &request); err != nil {
+		return Response{
+			Body:       fmt.Sprintf("internal error: %s", err),
+			StatusCode: http.StatusInternalServerError,
+		}
+	}
+
+	defer s.LockUnlock()()
+
+	model, ok := s.Models[request.Name]
+	if !ok {
+		return modelNotFound(request.Name)
+	}
+
+	model.Description = request.Description
+	s.Models[request.Name] = model
+	return Response{}
+}
+
+func (s *FakeWorkspace) ModelsRename(req Request) Response {
+	var request ml.RenameModelRequest
+
+	if err := json.Unmarshal(req.Body, &request); err != nil {
+		return Response{
+			Body:       fmt.Sprintf("internal error: %s", err),
+			StatusCode: http.StatusInternalServerError,
+		}
+	}
+
+	defer s.LockUnlock()()
+
+	model, ok := s.Models[request.Name]
+	if !ok {
+		return modelNotFound(request.Name)
+	}
+
+	delete(s.Models, request.Name)
+	model.Name = request.NewName
+	s.Models[model.Name] = model
+
+	return Response{
+		Body: ml.RenameModelResponse{
+			RegisteredModel: &ml.Model{
+				Description: model.Description,
+				Name:        model.Name,
+				Tags:        model.Tags,
+				UserId:      model.UserId,
+			},
+		},
+	}
+}
+
+func (s *FakeWorkspace) ModelsSetTag(req Request) Response {
+	var request ml.SetModelTagRequest
+
+	if err := json.Unmarshal(req.Body, &request); err != nil {
+		return Response{
+			Body:       fmt.Sprintf("internal error: %s", err),
+			StatusCode: http.StatusInternalServerError,
+		}
+	}
+
+	defer s.LockUnlock()()
+
+	model, ok := s.Models[request.Name]
+	if !ok {
+		return modelNotFound(request.Name)
+	}
+
+	found := false
+	for i := range model.Tags {
+		if model.Tags[i].Key == request.Key {
+			model.Tags[i].Value = request.Value
+			found = true
+		}
+	}
+	if !found {
+		model.Tags = append(model.Tags, ml.ModelTag{Key: request.Key, Value: request.Value})
+	}
+
+	s.Models[request.Name] = model
+	return Response{}
+}
+
+func (s *FakeWorkspace) ModelsDeleteTag(name, key string) Response {
+	defer s.LockUnlock()()
+
+	model, ok := s.Models[name]
+	if !ok {
+		return modelNotFound(name)
+	}
+
+	var tags []ml.ModelTag
+	for _, tag := range model.Tags {
+		if tag.Key != key {
+			tags = append(tags, tag)
+		}
+	}
+	model.Tags = tags
+
+	s.Models[name] = model
+	return Response{}
+}
+
+func (s *FakeWorkspace) ModelsDelete(name string) Response {
+	defer s.LockUnlock()()
+
+	if _, ok := s.Models[name]; !ok {
+		return modelNotFound(name)
+	}
+
+	delete(s.Models, name)
+	return Response{}
+}
@@ -0,0 +1,70 @@
+package testserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/databricks/databricks-sdk-go/service/catalog"
+)
+
+func (s *FakeWorkspace) RegisteredModelsCreate(req Request) Response {
+	var model catalog.RegisteredModelInfo
+
+	if err := json.Unmarshal(req.Body, &model); err != nil {
+		return Response{
+			Body:       fmt.Sprintf("internal error: %s", err),
+			StatusCode: http.StatusInternalServerError,
+		}
+	}
+
+	defer s.LockUnlock()()
+
+	model.FullName = model.CatalogName + "." + model.SchemaName + "." + model.Name
+	model.Owner = s.CurrentUser().UserName
+	model.CreatedBy = s.CurrentUser().UserName
+
+	s.RegisteredModels[model.FullName] = model
+	return Response{
+		Body: model,
+	}
+}
+
+func (s *FakeWorkspace) RegisteredModelsUpdate(req Request, fullname string) Response {
+	defer s.LockUnlock()()
+
+	existing, ok := s.RegisteredModels[fullname]
+	if !ok {
+		return Response{
+			StatusCode: 404,
+		}
+	}
+
+	var request catalog.UpdateRegisteredModelRequest
+
+	if err := json.Unmarshal(req.Body, &request); err != nil {
+		return Response{
+			Body:       fmt.Sprintf("internal error: %s", err),
+			StatusCode: http.StatusInternalServerError,
+		}
+	}
+
+	if request.Comment != "" {
+		existing.Comment = request.Comment
+	}
+
+	if request.Owner != "" {
+		existing.Owner = request.Owner
+	}
+
+	if request.NewName != "" {
+		delete(s.RegisteredModels, fullname)
+		existing.Name = request.NewName
+		existing.FullName = existing.CatalogName + "." + existing.SchemaName + "." + request.NewName
+	}
+
+	s.RegisteredModels[existing.FullName] = existing
+	return Response{
+		Body: existing,
+	}
+}
@@ -0,0 +1,258 @@
+package testserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/databricks/databricks-sdk-go/service/serving"
+	"github.com/google/uuid"
+)
+
+func servingEndpointNotFound(name string) Response {
+	return Response{
+		StatusCode: 404,
+		Body: map[string]string{
+			"error_code": "RESOURCE_DOES_NOT_EXIST",
+			"message":    fmt.Sprintf("Endpoint with name '%s' does not exist.", name),
+		},
+	}
+}
+
+// servingEndpointConfigOutput converts the config from a request to the config returned by the API.
+func servingEndpointConfigOutput(input *serving.EndpointCoreConfigInput) (*serving.EndpointCoreConfigOutput, error) {
+	if input == nil {
+		return nil, nil
+	}
+
+	data, err := json.Marshal(input)
+	if err != nil {
+		return nil, err
+	}
+
+	var output serving.EndpointCoreConfigOutput
+	err = json.Unmarshal(data, &output)
+	if err != nil {
+		return nil, err
+	}
+
+	output.ConfigVersion = 1
+	return &output, nil
+}
+
+func (s *FakeWorkspace) ServingEndpointsCreate(req Request) Response {
+	var request serving.CreateServingEndpoint
+
+	if err := json.Unmarshal(req.Body, &request); err != nil {
+		return Response{
+			Body:       fmt.Sprintf("internal error: %s", err),
+			StatusCode: http.StatusInternalServerError,
+		}
+	}
+
+	config, err := servingEndpointConfigOutput(request.Config)
+	if err != nil {
+		return Response{
+			Body:       fmt.Sprintf("internal error: %s", err),
+			StatusCode: http.StatusInternalServerError,
+		}
+	}
+
+	defer s.LockUnlock()()
+
+	if _, ok := s.ServingEndpoints[request.Name]; ok {
+		return Response{
+			StatusCode: 400,
+			Body: map[string]string{
+				"error_code": "RESOURCE_ALREADY_EXISTS",
+				"message":    fmt.Sprintf("Endpoint with name '%s' already exists.", request.Name),
+			},
+		}
+	}
+
+	endpoint := serving.ServingEndpointDetailed{
+		AiGateway:          request.AiGateway,
+		BudgetPolicyId:     request.BudgetPolicyId,
+		Config:             config,
+		Creator:            s.CurrentUser().UserName,
+		Description:        request.Description,
+		EmailNotifications: request.EmailNotifications,
+		Id:                 uuid.New().String(),
+		Name:               request.Name,
+		RouteOptimized:     request.RouteOptimized,
+		State: &serving.EndpointState{
+			ConfigUpdate: serving.EndpointStateConfigUpdateNotUpdating,
+			Ready:        serving.EndpointStateReadyReady,
+		},
+		Tags: request.Tags,
+	}
+
+	s.ServingEndpoints[request.Name] = endpoint
+	return Response{
+		Body: endpoint,
+	}
+}
+
+func (s *FakeWorkspace) ServingEndpointsUpdateConfig(req Request, name string) Response {
+	var request serving.EndpointCoreConfigInput
+
+	if err := json.Unmarshal(req.Body, &request); err != nil {
+		return Response{
+			Body:       fmt.Sprintf("internal error: %s", err),
+			StatusCode: http.StatusInternalServerError,
+		}
+	}
+
+	config, err := servingEndpointConfigOutput(&request)
+	if err != nil {
+		return Response{
+			Body:       fmt.Sprintf("internal error: %s", err),
+			StatusCode: http.StatusInternalServerError,
+		}
+	}
+
+	defer s.LockUnlock()()
+
+	endpoint, ok := s.ServingEndpoints[name]
+	if !ok {
+		return servingEndpointNotFound(name)
+	}
+
+	if endpoint.Config != nil {
+		config.ConfigVersion = endpoint.Config.ConfigVersion + 1
+	}
+	endpoint.Config = config
+
+	s.ServingEndpoints[name] = endpoint
+	return Response{
+		Body: endpoint,
+	}
+}
+
+func (s *FakeWorkspace) ServingEndpointsPatchTags(req Request, name string) Response {
+	var request serving.PatchServingEndpointTags
+
+	if err := json.Unmarshal(req.Body, &request); err != nil {
+		return Response{
+			Body:       fmt.Sprintf("internal error: %s", err),
+			StatusCode: http.StatusInternalServerError,
+		}
+	}
+
+	defer s.LockUnlock()()
+
+	endpoint, ok := s.ServingEndpoints[name]
+	if !ok {
+		return servingEndpointNotFound(name)
+	}
+
+	deleted := make(map[string]bool, len(request.DeleteTags))
+	for _, key := range request.DeleteTags {
+		deleted[key] = true
+	}
+
+	added := make(map[string]bool, len(request.AddTags))
+	for _, tag := range request.AddTags {
+		added[tag.Key] = true
+	}
+
+	var tags []serving.EndpointTag
+	for _, tag := range endpoint.Tags {
+		if !deleted[tag.Key] && !added[tag.Key] {
+			tags = append(tags, tag)
+		}
+	}
+	tags = append(tags, request.AddTags...)
+	endpoint.Tags = tags
+
+	s.ServingEndpoints[name] = endpoint
+	return Response{
+		Body: serving.EndpointTags{
+			Tags: endpoint.Tags,
+		},
+	}
+}
+
+func (s *FakeWorkspace) ServingEndpointsPutAiGateway(req Request, name string) Response {
+	var request serving.PutAiGatewayRequest
+
+	if err := json.Unmarshal(req.Body, &request); err != nil {
+		return Response{
+			Body:       fmt.Sprintf("internal error: %s", err),
+			StatusCode: http.StatusInternalServerError,
+		}
+	}
+
+	defer s.LockUnlock()()
+
+	endpoint, ok := s.ServingEndpoints[name]
+	if !ok {
+		return servingEndpointNotFound(name)
+	}
+
+	endpoint.AiGateway = &serving.AiGatewayConfig{
+		FallbackConfig:       request.FallbackConfig,
+		Guardrails:           request.Guardrails,
+		InferenceTableConfig: request.InferenceTableConfig,
+		RateLimits:           request.RateLimits,
+		UsageTrackingConfig:  request.UsageTrackingConfig,
+	}
+
+	s.ServingEndpoints[name] = endpoint
+	return Response{
+		Body: serving.PutAiGatewayResponse{
+			FallbackConfig:       request.FallbackConfig,
+			Guardrails:           request.Guardrails,
+			InferenceTableConfig: request.InferenceTableConfig,
+			RateLimits:           request.RateLimits,
+			UsageTrackingConfig:  request.UsageTrackingConfig,
+		},
+	}
+}
+
+func (s *FakeWorkspace) ServingEndpointsPutRateLimits(req Request, name string) Response {
+	var request serving.PutRequest
+
+	if err := json.Unmarshal(req.Body, &request); err != nil {
+		return Response{
+			Body:       fmt.Sprintf("internal error: %s", err),
+			StatusCode: http.StatusInternalServerError,
+		}
+	}
+
+	defer s.LockUnlock()()
+
+	if _, ok := s.ServingEndpoints[name]; !ok {
+		return servingEndpointNotFound(name)
+	}
+
+	return Response{
+		Body: serving.PutResponse{
+			RateLimits: request.RateLimits,
+		},
+	}
+}
+
+func (s *FakeWorkspace) ServingEndpointsGet(name string) Response {
+	defer s.LockUnlock()()
+
+	endpoint, ok := s.ServingEndpoints[name]
+	if !ok {
+		return servingEndpointNotFound(name)
+	}
+
+	return Response{
+		Body: endpoint,
+	}
+}
+
+func (s *FakeWorkspace) ServingEndpointsDelete(name string) Response {
+	defer s.LockUnlock()()
+
+	if _, ok := s.ServingEndpoints[name]; !ok {
+		return servingEndpointNotFound(name)
+	}
+
+	delete(s.ServingEndpoints, name)
+	return Response{}
+}